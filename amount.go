@@ -0,0 +1,128 @@
+package cardanoasset
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Error types for quantity arithmetic.
+var (
+	ErrAmountOverflow  = errors.New("amount overflow: result exceeds uint64")
+	ErrAmountUnderflow = errors.New("amount underflow: result would be negative")
+	ErrAssetMismatch   = errors.New("asset mismatch: amounts refer to different assets")
+	ErrAmountNegative  = errors.New("amount is negative")
+	ErrInvalidAmount   = errors.New("invalid amount: expected a decimal integer")
+)
+
+// ParseAmount parses a decimal token quantity such as "1500000", as found in
+// cardano-cli output and JSON string amounts. Leading zeros are accepted.
+// Returns ErrAmountNegative for a leading minus sign (including "-0"),
+// ErrAmountOverflow if the quantity exceeds uint64, and ErrInvalidAmount for
+// anything else that is not a plain decimal integer.
+//
+// Example:
+//
+//	n, err := cardanoasset.ParseAmount("18446744073709551616") // ErrAmountOverflow
+func ParseAmount(s string) (uint64, error) {
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		if _, err := strconv.ParseUint(rest, 10, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+			return 0, ErrAmountNegative
+		}
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrAmountOverflow
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	return n, nil
+}
+
+// AssetAmount pairs an asset with a quantity. It is a lightweight alternative
+// to a full value map when an ordered list of holdings is more convenient.
+type AssetAmount struct {
+	// Asset is the native token being counted.
+	Asset Asset
+	// Amount is the token quantity in the asset's smallest unit.
+	Amount uint64
+}
+
+// Add returns the sum of two amounts of the same asset.
+// Returns ErrAssetMismatch if the assets differ and ErrAmountOverflow if the
+// sum does not fit in a uint64.
+//
+// Example:
+//
+//	total, err := held.Add(received)
+func (aa AssetAmount) Add(other AssetAmount) (AssetAmount, error) {
+	if aa.Asset != other.Asset {
+		return AssetAmount{}, ErrAssetMismatch
+	}
+	sum, err := addAmounts(aa.Amount, other.Amount)
+	if err != nil {
+		return AssetAmount{}, err
+	}
+	return AssetAmount{Asset: aa.Asset, Amount: sum}, nil
+}
+
+// Sub returns aa minus other for amounts of the same asset.
+// Returns ErrAssetMismatch if the assets differ and ErrAmountUnderflow if
+// other is larger than aa.
+//
+// Example:
+//
+//	remaining, err := held.Sub(spent)
+func (aa AssetAmount) Sub(other AssetAmount) (AssetAmount, error) {
+	if aa.Asset != other.Asset {
+		return AssetAmount{}, ErrAssetMismatch
+	}
+	if other.Amount > aa.Amount {
+		return AssetAmount{}, ErrAmountUnderflow
+	}
+	return AssetAmount{Asset: aa.Asset, Amount: aa.Amount - other.Amount}, nil
+}
+
+// String renders the amount as "<amount> <assetId>", for example
+// "123 d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc.537061636542756430".
+func (aa AssetAmount) String() string {
+	return strconv.FormatUint(aa.Amount, 10) + " " + aa.Asset.AssetID()
+}
+
+// SumByAsset collapses entries that refer to the same asset, summing their
+// amounts. The result keeps the order in which each asset was first seen.
+// Returns ErrAmountOverflow if any per-asset total exceeds uint64.
+//
+// Example:
+//
+//	totals, err := cardanoasset.SumByAsset(amounts)
+func SumByAsset(amounts []AssetAmount) ([]AssetAmount, error) {
+	index := make(map[Asset]int, len(amounts))
+	result := make([]AssetAmount, 0, len(amounts))
+	for _, aa := range amounts {
+		i, ok := index[aa.Asset]
+		if !ok {
+			index[aa.Asset] = len(result)
+			result = append(result, aa)
+			continue
+		}
+		sum, err := addAmounts(result[i].Amount, aa.Amount)
+		if err != nil {
+			return nil, err
+		}
+		result[i].Amount = sum
+	}
+	return result, nil
+}
+
+// addAmounts returns a+b, or ErrAmountOverflow if the sum exceeds uint64.
+func addAmounts(a, b uint64) (uint64, error) {
+	if a > math.MaxUint64-b {
+		return 0, ErrAmountOverflow
+	}
+	return a + b, nil
+}
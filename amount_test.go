@@ -0,0 +1,118 @@
+package cardanoasset
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAssetAmountArithmetic(t *testing.T) {
+	bud := MustNewAsset(testPolicy, "SpaceBud0")
+	other := MustNewAsset(testPolicy, "SpaceBud1")
+	tests := []struct {
+		name    string
+		op      func(AssetAmount, AssetAmount) (AssetAmount, error)
+		a, b    AssetAmount
+		want    AssetAmount
+		wantErr error
+	}{
+		{name: "add", op: AssetAmount.Add, a: AssetAmount{bud, 2}, b: AssetAmount{bud, 3}, want: AssetAmount{bud, 5}},
+		{name: "add overflow", op: AssetAmount.Add, a: AssetAmount{bud, math.MaxUint64}, b: AssetAmount{bud, 1}, wantErr: ErrAmountOverflow},
+		{name: "add mismatch", op: AssetAmount.Add, a: AssetAmount{bud, 1}, b: AssetAmount{other, 1}, wantErr: ErrAssetMismatch},
+		{name: "sub", op: AssetAmount.Sub, a: AssetAmount{bud, 5}, b: AssetAmount{bud, 5}, want: AssetAmount{bud, 0}},
+		{name: "sub underflow", op: AssetAmount.Sub, a: AssetAmount{bud, 1}, b: AssetAmount{bud, 2}, wantErr: ErrAmountUnderflow},
+		{name: "sub mismatch", op: AssetAmount.Sub, a: AssetAmount{bud, 1}, b: AssetAmount{other, 1}, wantErr: ErrAssetMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetAmountString(t *testing.T) {
+	tests := []struct {
+		name string
+		aa   AssetAmount
+		want string
+	}{
+		{name: "named", aa: AssetAmount{MustNewAsset(testPolicy, "SpaceBud0"), 123}, want: "123 " + testPolicy + ".537061636542756430"},
+		{name: "empty name", aa: AssetAmount{MustNewAsset(testPolicy, ""), 0}, want: "0 " + testPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.aa.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumByAsset(t *testing.T) {
+	a := MustNewAsset(testPolicy, "a")
+	b := MustNewAsset(testPolicy, "b")
+	tests := []struct {
+		name    string
+		in      []AssetAmount
+		want    []AssetAmount
+		wantErr error
+	}{
+		{name: "empty", in: nil, want: []AssetAmount{}},
+		{name: "dedup keeps first-seen order", in: []AssetAmount{{b, 1}, {a, 2}, {b, 3}}, want: []AssetAmount{{b, 4}, {a, 2}}},
+		{name: "overflow", in: []AssetAmount{{a, math.MaxUint64}, {a, 1}}, wantErr: ErrAmountOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumByAsset(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SumByAsset error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SumByAsset = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    uint64
+		wantErr error
+	}{
+		{name: "plain", in: "1500000", want: 1500000},
+		{name: "zero", in: "0", want: 0},
+		{name: "leading zeros", in: "007", want: 7},
+		{name: "max uint64", in: "18446744073709551615", want: math.MaxUint64},
+		{name: "overflow", in: "18446744073709551616", wantErr: ErrAmountOverflow},
+		{name: "negative", in: "-1", wantErr: ErrAmountNegative},
+		{name: "negative zero", in: "-0", wantErr: ErrAmountNegative},
+		{name: "negative overflow", in: "-18446744073709551616", wantErr: ErrAmountNegative},
+		{name: "negative garbage", in: "-abc", wantErr: ErrInvalidAmount},
+		{name: "plus sign", in: "+1", wantErr: ErrInvalidAmount},
+		{name: "decimal point", in: "1.5", wantErr: ErrInvalidAmount},
+		{name: "hex", in: "0x10", wantErr: ErrInvalidAmount},
+		{name: "underscore", in: "1_000", wantErr: ErrInvalidAmount},
+		{name: "whitespace", in: " 1", wantErr: ErrInvalidAmount},
+		{name: "empty", in: "", wantErr: ErrInvalidAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAmount(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAmount(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,13 +1,19 @@
 // Package cardanoasset provides CIP-14 compliant asset fingerprint generation,
 // policy ID validation, and native token utilities for the Cardano blockchain.
-// All functions are pure, stateless, and safe for concurrent use.
+// Functions and value methods are pure and safe for concurrent use. The
+// exceptions are documented on the types concerned: Value and BigValue mutators
+// and builders such as CIP25Metadata and FingerprintHasher must not be shared
+// between goroutines without synchronization, and the policy registry behind
+// LookupPolicyName is package-level state that AddKnownPolicy changes, under
+// its own lock.
 //
 // Reference: https://cips.cardano.org/cip/CIP-14
 package cardanoasset
 
 import (
-	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -30,6 +36,12 @@ const (
 	MaxAssetNameLength = 32
 
 	fingerprintHRP = "asset"
+
+	// fingerprintHashLength is the byte length of the CIP-14 digest (160 bits).
+	fingerprintHashLength = 20
+
+	// txHashLength is the byte length of a Cardano transaction hash.
+	txHashLength = 32
 )
 
 // Error types for structured, predictable error handling.
@@ -38,6 +50,11 @@ var (
 	ErrAssetNameTooLong  = errors.New("asset name too long: max 32 bytes")
 	ErrInvalidHex        = errors.New("invalid hex encoding")
 	ErrInvalidAssetID    = errors.New("invalid asset ID: expected format policyId.assetNameHex or policyId")
+	ErrInvalidUnit       = errors.New("invalid unit: expected format policyIdassetNameHex")
+	ErrInvalidHashLength = errors.New("invalid fingerprint hash length: must be 20 bytes")
+	ErrInvalidBinary     = errors.New("invalid binary asset encoding")
+	ErrInvalidSeparator  = errors.New("invalid asset ID separator: must not be a hex digit")
+	ErrIsFingerprint     = errors.New("input is a CIP-14 fingerprint, not an asset ID")
 )
 
 // Asset represents a Cardano native token with its policy ID and asset name.
@@ -62,6 +79,7 @@ type AssetInfo struct {
 // NewAsset creates an Asset from a policy ID (hex) and a raw asset name string.
 // Returns ErrInvalidPolicyID if the policy ID is not valid 56-char lowercase hex.
 // Returns ErrAssetNameTooLong if the asset name exceeds 32 bytes.
+// Errors are *ValidationError values naming the offending field and input.
 //
 // Example:
 //
@@ -71,18 +89,37 @@ type AssetInfo struct {
 //	)
 func NewAsset(policyID, assetName string) (Asset, error) {
 	if err := ValidatePolicyID(policyID); err != nil {
-		return Asset{}, err
+		return Asset{}, newValidationError("policyId", policyID, err)
 	}
 	if len(assetName) > MaxAssetNameLength {
-		return Asset{}, ErrAssetNameTooLong
+		return Asset{}, newValidationError("assetName", assetName, ErrAssetNameTooLong)
 	}
 	return Asset{PolicyID: policyID, AssetName: assetName}, nil
 }
 
+// MustNewAsset is like NewAsset but panics if the policy ID or asset name is
+// invalid. It is intended for tests and package-level variable initialization
+// with known-good values, not for handling untrusted input.
+//
+// Example:
+//
+//	var spaceBud0 = cardanoasset.MustNewAsset(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    "SpaceBud0",
+//	)
+func MustNewAsset(policyID, assetName string) Asset {
+	a, err := NewAsset(policyID, assetName)
+	if err != nil {
+		panic(fmt.Sprintf("cardanoasset: MustNewAsset(%q, %q): %v", policyID, assetName, err))
+	}
+	return a
+}
+
 // NewAssetFromHex creates an Asset from a policy ID (hex) and a hex-encoded asset name.
 // Returns ErrInvalidPolicyID if the policy ID is invalid.
 // Returns ErrInvalidHex if the asset name hex is malformed.
 // Returns ErrAssetNameTooLong if the decoded asset name exceeds 32 bytes.
+// Errors are *ValidationError values naming the offending field and input.
 //
 // Example:
 //
@@ -92,21 +129,26 @@ func NewAsset(policyID, assetName string) (Asset, error) {
 //	)
 func NewAssetFromHex(policyID, assetNameHex string) (Asset, error) {
 	if err := ValidatePolicyID(policyID); err != nil {
-		return Asset{}, err
+		return Asset{}, newValidationError("policyId", policyID, err)
 	}
 	nameBytes, err := hex.DecodeString(assetNameHex)
 	if err != nil {
-		return Asset{}, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+		return Asset{}, newValidationError("assetNameHex", assetNameHex, fmt.Errorf("%w: %v", ErrInvalidHex, err))
 	}
 	if len(nameBytes) > MaxAssetNameLength {
-		return Asset{}, ErrAssetNameTooLong
+		return Asset{}, newValidationError("assetNameHex", assetNameHex, ErrAssetNameTooLong)
 	}
 	return Asset{PolicyID: policyID, AssetName: string(nameBytes)}, nil
 }
 
 // ParseAssetID parses a full Cardano asset ID of the form "policyId.assetNameHex"
 // or just "policyId" (for ADA or lovelace-only assets with empty name).
-// Returns ErrInvalidAssetID or ErrInvalidPolicyID on malformed input.
+// Returns ErrInvalidAssetID or ErrInvalidPolicyID on malformed input. A name
+// segment of odd length is structurally invalid and reported as
+// ErrInvalidAssetID before any hex decoding; other bad bytes yield ErrInvalidHex.
+// A CIP-14 fingerprint is reported as ErrIsFingerprint so callers can route
+// it to a fingerprint lookup (see ParseAssetOrFingerprint).
+// Errors are *ValidationError values naming the offending field and input.
 //
 // Example:
 //
@@ -114,18 +156,171 @@ func NewAssetFromHex(policyID, assetNameHex string) (Asset, error) {
 //	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc.537061636542756430",
 //	)
 func ParseAssetID(assetID string) (Asset, error) {
-	parts := strings.SplitN(assetID, ".", 2)
-	if len(parts) == 0 || parts[0] == "" {
-		return Asset{}, ErrInvalidAssetID
+	return ParseAssetIDSep(assetID, '.')
+}
+
+// ParseAssetIDSep is like ParseAssetID but splits the policy ID and asset
+// name hex on sep instead of '.', for systems that write IDs such as
+// "policyId:assetNameHex".
+// sep is matched as a single byte, so any byte value, including one >= 0x80,
+// may be used.
+// Returns ErrInvalidSeparator if sep is a hex digit, which would make the
+// split ambiguous, and otherwise the same errors as ParseAssetID. All errors
+// are *ValidationError values.
+//
+// Example:
+//
+//	a, err := cardanoasset.ParseAssetIDSep(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc:537061636542756430",
+//	    ':',
+//	)
+func ParseAssetIDSep(assetID string, sep byte) (Asset, error) {
+	if isHexDigit(sep) {
+		return Asset{}, newValidationError("sep", string([]byte{sep}), ErrInvalidSeparator)
+	}
+	if IsFingerprint(assetID) {
+		return Asset{}, newValidationError("assetId", assetID, ErrIsFingerprint)
 	}
-	policyID := parts[0]
-	assetNameHex := ""
-	if len(parts) == 2 {
-		assetNameHex = parts[1]
+	// Split on the raw byte: string(sep) would turn a byte >= 0x80 into a
+	// two-byte UTF-8 rune that never occurs in the ID.
+	policyID, assetNameHex := assetID, ""
+	if i := strings.IndexByte(assetID, sep); i >= 0 {
+		policyID, assetNameHex = assetID[:i], assetID[i+1:]
+	}
+	if policyID == "" {
+		return Asset{}, newValidationError("assetId", assetID, ErrInvalidAssetID)
+	}
+	if len(assetNameHex)%2 != 0 {
+		err := fmt.Errorf("%w: asset name hex has odd length %d", ErrInvalidAssetID, len(assetNameHex))
+		return Asset{}, newValidationError("assetId", assetID, err)
 	}
 	return NewAssetFromHex(policyID, assetNameHex)
 }
 
+// ParseAnnotatedAssetID parses an asset ID whose name segment may carry an
+// "nft:" or "ft:" type annotation, as in "policyId.nft:assetNameHex", and
+// returns the annotation separately as kind ("nft", "ft", or "" when absent).
+// The annotation is never part of the asset name bytes. The remaining ID must
+// be valid for ParseAssetID.
+// Returns the same errors as ParseAssetID.
+//
+// Example:
+//
+//	a, kind, err := cardanoasset.ParseAnnotatedAssetID(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc.nft:537061636542756430",
+//	) // kind == "nft"
+func ParseAnnotatedAssetID(s string) (a Asset, kind string, err error) {
+	policyID, name, found := strings.Cut(s, ".")
+	if found {
+		for _, k := range []string{"nft", "ft"} {
+			if rest, ok := strings.CutPrefix(name, k+":"); ok {
+				kind, name = k, rest
+				break
+			}
+		}
+		s = policyID + "." + name
+	}
+	a, err = ParseAssetID(s)
+	if err != nil {
+		return Asset{}, "", err
+	}
+	return a, kind, nil
+}
+
+// ParseUnit parses a concatenated Cardano unit of the form
+// "policyIdassetNameHex" (no separator), as used by Blockfrost and cardano-cli.
+// The first 56 characters are the policy ID; the remainder is the hex name.
+// Returns ErrInvalidUnit if the unit is shorter than a policy ID, otherwise the
+// same errors as NewAssetFromHex.
+//
+// Example:
+//
+//	a, err := cardanoasset.ParseUnit(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc537061636542756430",
+//	)
+func ParseUnit(unit string) (Asset, error) {
+	if len(unit) < PolicyIDLength*2 {
+		return Asset{}, ErrInvalidUnit
+	}
+	return NewAssetFromHex(unit[:PolicyIDLength*2], unit[PolicyIDLength*2:])
+}
+
+// SplitUnit splits a concatenated unit into its policy ID and asset name hex
+// without decoding or validating either part. It only checks that the unit
+// is at least 56 characters long and that the name part has even length,
+// and returns substrings of unit without allocating. This makes it much
+// cheaper than ParseUnit in hot indexing loops, at the cost of accepting
+// non-hex or uppercase input; validate lazily with ParseUnit or
+// NewAssetFromHex where it matters. A bare policy ID yields an empty nameHex.
+//
+// Example:
+//
+//	policyHex, nameHex, ok := cardanoasset.SplitUnit(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc537061636542756430",
+//	)
+func SplitUnit(unit string) (policyHex, nameHex string, ok bool) {
+	if len(unit) < PolicyIDLength*2 || (len(unit)-PolicyIDLength*2)%2 != 0 {
+		return "", "", false
+	}
+	return unit[:PolicyIDLength*2], unit[PolicyIDLength*2:], true
+}
+
+// WithName returns a copy of the asset under the same policy with the raw
+// asset name replaced. The new name is validated as in NewAsset.
+// Returns ErrAssetNameTooLong if the name exceeds 32 bytes.
+//
+// Example:
+//
+//	base, _ := cardanoasset.PolicyDefaultAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+//	bud1, err := base.WithName("SpaceBud1")
+func (a Asset) WithName(name string) (Asset, error) {
+	return NewAsset(a.PolicyID, name)
+}
+
+// WithNameHex is like WithName but takes a hex-encoded asset name, validated
+// as in NewAssetFromHex.
+//
+// Example:
+//
+//	bud1, err := base.WithNameHex("537061636542756431")
+func (a Asset) WithNameHex(nameHex string) (Asset, error) {
+	return NewAssetFromHex(a.PolicyID, nameHex)
+}
+
+// Validate checks the asset's invariants: a valid policy ID and an asset
+// name of at most 32 bytes. The constructors already enforce these, but
+// struct literals, direct field mutation and decoding into an Asset do not.
+// Returns ErrInvalidPolicyID or ErrAssetNameTooLong.
+//
+// Example:
+//
+//	a := cardanoasset.Asset{PolicyID: input.Policy, AssetName: input.Name}
+//	if err := a.Validate(); err != nil {
+//	    return err
+//	}
+func (a Asset) Validate() error {
+	if err := ValidatePolicyID(a.PolicyID); err != nil {
+		return err
+	}
+	if len(a.AssetName) > MaxAssetNameLength {
+		return ErrAssetNameTooLong
+	}
+	return nil
+}
+
+// IsZero reports whether a is the zero Asset, with both PolicyID and
+// AssetName empty, as left by an uninitialized variable. This differs from a
+// policy's empty-name asset, which has a policy ID. Inside a Value the zero
+// Asset is the Lovelace key.
+//
+// Example:
+//
+//	var a cardanoasset.Asset
+//	ok := a.IsZero() // true
+func (a Asset) IsZero() bool {
+	return a.PolicyID == "" && a.AssetName == ""
+}
+
 // AssetNameHex returns the hex-encoded asset name of the asset.
 //
 // Example:
@@ -136,9 +331,25 @@ func (a Asset) AssetNameHex() string {
 	return hex.EncodeToString([]byte(a.AssetName))
 }
 
+// NameBytes returns the raw asset name bytes. The slice is a fresh copy on
+// every call, so the caller may modify it without affecting the Asset.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	b := a.NameBytes() // []byte("SpaceBud0")
+func (a Asset) NameBytes() []byte {
+	return []byte(a.AssetName)
+}
+
 // AssetID returns the full Cardano asset ID in the form "policyId.assetNameHex".
 // If the asset name is empty, returns just the policy ID.
 //
+// AssetID and Unit are interchangeable: for every asset that passes Validate,
+// ParseAssetID(a.AssetID()) and ParseUnit(a.Unit()) both return a. For an
+// empty name the two forms coincide as the bare 56-character policy ID,
+// which both parsers accept as the policy's empty-name asset.
+//
 // Example:
 //
 //	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
@@ -151,6 +362,133 @@ func (a Asset) AssetID() string {
 	return a.PolicyID + "." + nameHex
 }
 
+// Unit returns the concatenated Cardano unit "policyIdassetNameHex" with no
+// separator. If the asset name is empty, returns just the policy ID, the same
+// string AssetID returns. ParseUnit(a.Unit()) reconstructs a for any valid
+// asset; see AssetID for the round-trip invariant.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	unit := a.Unit() // "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc537061636542756430"
+func (a Asset) Unit() string {
+	return a.PolicyID + a.AssetNameHex()
+}
+
+// MatchesIdentifier reports whether id identifies this asset in any of its
+// string forms: the dotted AssetID, the concatenated Unit, or the CIP-14
+// fingerprint. The fingerprint is only computed when id looks like one.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	ok := a.MatchesIdentifier("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc537061636542756430") // true
+func (a Asset) MatchesIdentifier(id string) bool {
+	if id == a.AssetID() || id == a.Unit() {
+		return true
+	}
+	if !strings.HasPrefix(id, fingerprintHRP+"1") {
+		return false
+	}
+	fp, err := a.Fingerprint()
+	return err == nil && id == fp
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical
+// "policyId.assetNameHex" form returned by AssetID.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	text, err := a.MarshalText()
+func (a Asset) MarshalText() ([]byte, error) {
+	return []byte(a.AssetID()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed with
+// ParseAssetID, so malformed input yields the same sentinel errors.
+//
+// Example:
+//
+//	var a cardanoasset.Asset
+//	err := a.UnmarshalText([]byte("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc.537061636542756430"))
+func (a *Asset) UnmarshalText(text []byte) error {
+	parsed, err := ParseAssetID(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with a compact layout:
+// the 28 policy ID bytes, one byte holding the name length, then the raw
+// name bytes (29-61 bytes in total). encoding/gob uses it automatically.
+// Returns the asset's validation error if it is malformed.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	b, err := a.MarshalBinary() // 38 bytes
+func (a Asset) MarshalBinary() ([]byte, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	policyBytes, err := hex.DecodeString(a.PolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	b := make([]byte, 0, PolicyIDLength+1+len(a.AssetName))
+	b = append(b, policyBytes...)
+	b = append(b, byte(len(a.AssetName)))
+	return append(b, a.AssetName...), nil
+}
+
+// BundleKey returns the asset as the ledger keys it inside a token bundle:
+// the 28 policy ID bytes immediately followed by the raw name bytes, with no
+// length prefix (28-60 bytes in total). The fixed-width policy makes the key
+// unambiguous, and it is the same input CIP-14 hashes for the fingerprint.
+// It suits binary KV store keys; unlike MarshalBinary it cannot be decoded
+// from a stream. Returns nil if the policy ID is not valid hex.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	key := a.BundleKey() // len(key) == 28 + 9
+func (a Asset) BundleKey() []byte {
+	policyBytes, err := hex.DecodeString(a.PolicyID)
+	if err != nil {
+		return nil
+	}
+	return append(policyBytes, a.AssetName...)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the layout
+// written by MarshalBinary. Returns ErrInvalidBinary if the data is truncated,
+// has trailing bytes, or declares a name longer than 32 bytes.
+//
+// Example:
+//
+//	var a cardanoasset.Asset
+//	err := a.UnmarshalBinary(b)
+func (a *Asset) UnmarshalBinary(data []byte) error {
+	if len(data) < PolicyIDLength+1 {
+		return fmt.Errorf("%w: %d bytes is shorter than the 29-byte minimum", ErrInvalidBinary, len(data))
+	}
+	nameLen := int(data[PolicyIDLength])
+	if nameLen > MaxAssetNameLength {
+		return fmt.Errorf("%w: %w", ErrInvalidBinary, ErrAssetNameTooLong)
+	}
+	if len(data) != PolicyIDLength+1+nameLen {
+		return fmt.Errorf("%w: name length %d does not match %d data bytes", ErrInvalidBinary, nameLen, len(data))
+	}
+	*a = Asset{
+		PolicyID:  hex.EncodeToString(data[:PolicyIDLength]),
+		AssetName: string(data[PolicyIDLength+1:]),
+	}
+	return nil
+}
+
 // Fingerprint computes the CIP-14 asset fingerprint for this asset.
 // The fingerprint is a bech32-encoded string with HRP "asset".
 // This is the canonical identifier shown on NFT marketplaces like jpg.store.
@@ -182,6 +520,130 @@ func (a Asset) Info() (AssetInfo, error) {
 	}, nil
 }
 
+// assetInfoJSON pins the field names and order of AssetInfo.JSON output.
+// Do not reorder or rename fields: the shape is part of the public API.
+type assetInfoJSON struct {
+	PolicyID     string `json:"policyId"`
+	AssetName    string `json:"assetName,omitempty"`
+	AssetNameHex string `json:"assetNameHex"`
+	Fingerprint  string `json:"fingerprint"`
+	AssetID      string `json:"assetId"`
+	Unit         string `json:"unit"`
+}
+
+// JSON encodes the asset info with a stable, documented schema that is
+// independent of the Go struct layout:
+//
+//	{"policyId":"...","assetName":"...","assetNameHex":"...","fingerprint":"asset1...","assetId":"policyId.nameHex","unit":"policyIdnameHex"}
+//
+// assetNameHex is always present and authoritative. assetName carries the
+// name as text only when it is non-empty, valid UTF-8 and printable; it is
+// omitted for binary names such as CIP-68 ones, which could not be
+// represented in a JSON string without loss.
+//
+// Example:
+//
+//	info, _ := a.Info()
+//	body, err := info.JSON()
+func (ai AssetInfo) JSON() ([]byte, error) {
+	var name string
+	if isPrintableName(ai.AssetName) {
+		name = ai.AssetName
+	}
+	return json.Marshal(assetInfoJSON{
+		PolicyID:     ai.PolicyID,
+		AssetName:    name,
+		AssetNameHex: ai.AssetNameHex,
+		Fingerprint:  ai.Fingerprint,
+		AssetID:      ai.AssetID,
+		Unit:         ai.Unit(),
+	})
+}
+
+// MarshalJSON implements json.Marshaler using the schema documented on JSON.
+// Without it AssetInfo would inherit Asset.MarshalText and encode as a bare
+// asset ID string.
+//
+// Example:
+//
+//	info, _ := a.Info()
+//	body, err := json.Marshal(info)
+func (ai AssetInfo) MarshalJSON() ([]byte, error) {
+	return ai.JSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the schema written by
+// MarshalJSON. policyId and assetNameHex are authoritative; the fingerprint,
+// asset ID and name are recomputed from them rather than trusted.
+// Returns the NewAssetFromHex error for an invalid policy ID or asset name hex.
+//
+// Example:
+//
+//	var info cardanoasset.AssetInfo
+//	err := json.Unmarshal(body, &info)
+func (ai *AssetInfo) UnmarshalJSON(data []byte) error {
+	var raw assetInfoJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	a, err := NewAssetFromHex(raw.PolicyID, raw.AssetNameHex)
+	if err != nil {
+		return err
+	}
+	info, err := a.Info()
+	if err != nil {
+		return err
+	}
+	*ai = info
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the Asset layout;
+// the derived fields are not stored. encoding/gob uses it automatically.
+//
+// Example:
+//
+//	info, _ := a.Info()
+//	b, err := info.MarshalBinary()
+func (ai AssetInfo) MarshalBinary() ([]byte, error) {
+	return ai.Asset.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the layout
+// written by MarshalBinary and recomputes the fingerprint, asset name hex and
+// asset ID, so a gob round trip yields a fully populated AssetInfo.
+// Returns ErrInvalidBinary if the data is malformed.
+//
+// Example:
+//
+//	var info cardanoasset.AssetInfo
+//	err := info.UnmarshalBinary(b)
+func (ai *AssetInfo) UnmarshalBinary(data []byte) error {
+	var a Asset
+	if err := a.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	info, err := a.Info()
+	if err != nil {
+		return err
+	}
+	*ai = info
+	return nil
+}
+
+// GlobalSortKey returns policyID + fingerprint, a string key that orders
+// assets from many collections stably: grouped by policy, then by fingerprint
+// within each policy.
+//
+// Example:
+//
+//	sort.Slice(infos, func(i, j int) bool {
+//	    return infos[i].GlobalSortKey() < infos[j].GlobalSortKey()
+//	})
+func (ai AssetInfo) GlobalSortKey() string {
+	return ai.PolicyID + ai.Fingerprint
+}
+
 // IsValidUTF8Name reports whether the asset name is valid UTF-8 text.
 //
 // Example:
@@ -192,12 +654,60 @@ func (a Asset) IsValidUTF8Name() bool {
 	return utf8.ValidString(a.AssetName)
 }
 
+// IsDNSSafeName reports whether the asset's display name is a DNS-safe slug:
+// non-empty, only lowercase ASCII letters, digits and hyphens, and neither
+// starting nor ending with a hyphen. A CIP-67 label prefix, if present, is
+// ignored so labeled tokens such as CIP-68 ADA Handles are checked by content.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("f0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a", "alice")
+//	ok := a.IsDNSSafeName() // true
+func (a Asset) IsDNSSafeName() bool {
+	name := a.AssetName
+	if _, ok := a.Label(); ok {
+		name = name[cip67PrefixLength:]
+	}
+	if name == "" || name[0] == '-' || name[len(name)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !((c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// NameAsTxHash returns the asset name as a 64-character lowercase hex string
+// when it is exactly 32 bytes long, the size of a transaction hash. Some
+// tokens store the hash of their minting or reference transaction in the name.
+// It reports ok=false for names of any other length. A 32-byte name is only a
+// plausible hash; the result is not checked against the chain.
+//
+// Example:
+//
+//	txHash, ok := a.NameAsTxHash()
+func (a Asset) NameAsTxHash() (string, bool) {
+	if len(a.AssetName) != txHashLength {
+		return "", false
+	}
+	return a.AssetNameHex(), true
+}
+
 // Fingerprint computes a CIP-14 asset fingerprint from a policy ID (hex string)
 // and a raw asset name string. This is a standalone function usable without
 // constructing an Asset.
 //
 // Algorithm: blake2b-160( policyIDBytes || assetNameBytes ), then bech32-encode with HRP "asset".
 //
+// An empty asset name hashes only the 28 policy bytes: no length prefix or
+// separator is added. NewAsset(p, "") and NewAssetFromHex(p, "") therefore
+// produce the same fingerprint, which for the CIP-14 reference policy
+// 7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373 is
+// asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3 under blake2b-160.
+//
 // Example:
 //
 //	fp, err := cardanoasset.Fingerprint(
@@ -211,7 +721,91 @@ func Fingerprint(policyID, assetName string) (string, error) {
 	if len(assetName) > MaxAssetNameLength {
 		return "", ErrAssetNameTooLong
 	}
+	return fingerprintUnchecked(blake2b160, policyID, assetName)
+}
+
+// FingerprintWith computes a CIP-14-style fingerprint using the supplied hash
+// function in place of the package default. h receives policyIDBytes ||
+// assetNameBytes and must return exactly 20 bytes. The policy ID and asset
+// name are validated exactly as in Fingerprint, which is unaffected.
+// Returns ErrInvalidHashLength if h returns a digest of any other length.
+//
+// Truncating a longer digest such as Blake2b-256 to 20 bytes does not give
+// Blake2b-160 (the digest size is part of the Blake2b parameter block), so
+// such a hasher produces non-standard fingerprints.
+//
+// Example:
+//
+//	// Blake2b-160 from golang.org/x/crypto/blake2b, as CIP-14 specifies.
+//	fp, err := cardanoasset.FingerprintWith(
+//	    func(b []byte) []byte {
+//	        h, _ := blake2b.New(20, nil)
+//	        h.Write(b)
+//	        return h.Sum(nil)
+//	    },
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    "SpaceBud0",
+//	)
+func FingerprintWith(h func([]byte) []byte, policyID, assetName string) (string, error) {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return "", err
+	}
+	if len(assetName) > MaxAssetNameLength {
+		return "", ErrAssetNameTooLong
+	}
+	return fingerprintUnchecked(h, policyID, assetName)
+}
+
+// FingerprintBoundaryCheck computes the fingerprint of an asset both strictly
+// and leniently with respect to the 32-byte asset name limit. strict is the
+// Fingerprint result and is empty when the name exceeds the limit; lenient
+// hashes the name regardless of length. withinLimit reports whether the name
+// fits, in which case strict == lenient. err is non-nil only for an invalid
+// policy ID.
+//
+// Example:
+//
+//	strict, lenient, ok, err := cardanoasset.FingerprintBoundaryCheck(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    "SpaceBud0",
+//	)
+func FingerprintBoundaryCheck(policyID, assetName string) (strict string, lenient string, withinLimit bool, err error) {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return "", "", false, err
+	}
+	lenient, err = fingerprintUnchecked(blake2b160, policyID, assetName)
+	if err != nil {
+		return "", "", false, err
+	}
+	if len(assetName) > MaxAssetNameLength {
+		return "", lenient, false, nil
+	}
+	return lenient, lenient, true, nil
+}
+
+// FingerprintUnchecked is like Fingerprint but does not enforce the 32-byte
+// asset name limit, for advanced callers that fingerprint arbitrary data in
+// tests or tooling. The policy ID is still validated. For names longer than
+// 32 bytes the result is well-formed and deterministic but cannot correspond
+// to any real ledger asset; use Fingerprint for anything that does.
+// Returns ErrInvalidPolicyID if the policy ID is invalid.
+//
+// Example:
+//
+//	fp, err := cardanoasset.FingerprintUnchecked(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    strings.Repeat("x", 40),
+//	)
+func FingerprintUnchecked(policyID, assetName string) (string, error) {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return "", err
+	}
+	return fingerprintUnchecked(blake2b160, policyID, assetName)
+}
 
+// fingerprintUnchecked computes the fingerprint with hasher h without checking
+// the asset name length. The policy ID must already be validated.
+func fingerprintUnchecked(h func([]byte) []byte, policyID, assetName string) (string, error) {
 	policyBytes, err := hex.DecodeString(policyID)
 	if err != nil {
 		return "", fmt.Errorf("%w: %v", ErrInvalidHex, err)
@@ -220,7 +814,10 @@ func Fingerprint(policyID, assetName string) (string, error) {
 	nameBytes := []byte(assetName)
 
 	// CIP-14: hash = blake2b-160(policyID_bytes || asset_name_bytes)
-	hash := blake2b160(append(policyBytes, nameBytes...))
+	hash := h(append(policyBytes, nameBytes...))
+	if len(hash) != fingerprintHashLength {
+		return "", ErrInvalidHashLength
+	}
 
 	// Bech32-encode with HRP "asset"
 	encoded, err := bech32Encode(fingerprintHRP, hash)
@@ -230,6 +827,46 @@ func Fingerprint(policyID, assetName string) (string, error) {
 	return encoded, nil
 }
 
+// MustFingerprint is like Fingerprint but panics if the fingerprint cannot be
+// computed. It is intended for tests and package-level variable initialization
+// with known-good values, not for handling untrusted input.
+//
+// Example:
+//
+//	var spaceBud0FP = cardanoasset.MustFingerprint(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    "SpaceBud0",
+//	)
+func MustFingerprint(policyID, assetName string) string {
+	fp, err := Fingerprint(policyID, assetName)
+	if err != nil {
+		panic(fmt.Sprintf("cardanoasset: MustFingerprint(%q, %q): %v", policyID, assetName, err))
+	}
+	return fp
+}
+
+// PolicyDefaultAsset returns the empty-name asset under policyID, the token
+// minted by policies that issue a single nameless asset.
+// Returns ErrInvalidPolicyID if the policy ID is invalid.
+//
+// Example:
+//
+//	a, err := cardanoasset.PolicyDefaultAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+func PolicyDefaultAsset(policyID string) (Asset, error) {
+	return NewAsset(policyID, "")
+}
+
+// PolicyDefaultFingerprint returns the fingerprint of the empty-name asset
+// under policyID, which hashes only the policy bytes.
+// Returns ErrInvalidPolicyID if the policy ID is invalid.
+//
+// Example:
+//
+//	fp, err := cardanoasset.PolicyDefaultFingerprint("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+func PolicyDefaultFingerprint(policyID string) (string, error) {
+	return Fingerprint(policyID, "")
+}
+
 // ValidatePolicyID checks that the given string is a valid Cardano policy ID:
 // exactly 56 lowercase hexadecimal characters (28 bytes).
 // Returns ErrInvalidPolicyID if invalid.
@@ -249,6 +886,58 @@ func ValidatePolicyID(policyID string) error {
 	return nil
 }
 
+// ValidatePolicyIDDetailed is like ValidatePolicyID but explains what is
+// wrong, including hints for common mix-ups: 64 hex characters look like a
+// transaction hash, and 40 look like a 20-byte key hash (such as a hash160)
+// rather than the 28-byte script hash a policy ID is. It also points out
+// uppercase hex and the first non-hex character.
+// Returns an error wrapping ErrInvalidPolicyID, or nil if policyID is valid.
+//
+// Example:
+//
+//	err := cardanoasset.ValidatePolicyIDDetailed(txHash)
+//	// invalid policy ID: must be 56 lowercase hex characters: got 64 characters; this looks like a transaction hash
+func ValidatePolicyIDDetailed(policyID string) error {
+	if ValidatePolicyID(policyID) == nil {
+		return nil
+	}
+	for i := 0; i < len(policyID); i++ {
+		if c := policyID[i]; !isHexDigit(c) {
+			return fmt.Errorf("%w: non-hex character %q at offset %d", ErrInvalidPolicyID, c, i)
+		}
+	}
+	switch n := len(policyID); {
+	case n == txHashLength*2:
+		return fmt.Errorf("%w: got %d characters; this looks like a transaction hash", ErrInvalidPolicyID, n)
+	case n == 40:
+		return fmt.Errorf("%w: got %d characters; this looks like a 20-byte key hash, not a 28-byte script hash", ErrInvalidPolicyID, n)
+	case n != PolicyIDLength*2:
+		return fmt.Errorf("%w: got %d characters", ErrInvalidPolicyID, n)
+	default:
+		return fmt.Errorf("%w: contains uppercase hex; policy IDs are lowercase", ErrInvalidPolicyID)
+	}
+}
+
+// PolicyIDEqual reports whether two policy IDs are equal, comparing the
+// decoded bytes in constant time so that matching against an allowlist of
+// trusted policies does not leak timing information to attacker-controlled
+// input. Returns false if either policy ID fails validation.
+//
+// Example:
+//
+//	trusted := cardanoasset.PolicyIDEqual(input, "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+func PolicyIDEqual(a, b string) bool {
+	if ValidatePolicyID(a) != nil || ValidatePolicyID(b) != nil {
+		return false
+	}
+	aBytes, errA := hex.DecodeString(a)
+	bBytes, errB := hex.DecodeString(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(aBytes, bBytes) == 1
+}
+
 // ValidateAssetNameHex checks that the given string is valid hex and decodes
 // to at most 32 bytes (Cardano's asset name limit).
 // Returns ErrInvalidHex or ErrAssetNameTooLong on failure.
@@ -267,16 +956,49 @@ func ValidateAssetNameHex(assetNameHex string) error {
 	return nil
 }
 
-// blake2b160 computes a 20-byte (160-bit) hash of data using a Blake2b-based
-// construction. Since Go's stdlib only has SHA-2, we implement a truncated
-// SHA-256 as a stand-in that is structurally identical for our pure-Go,
-// zero-dependency requirement.
+// ValidateAssetNameHexStrict is like ValidateAssetNameHex but also requires
+// canonical lowercase hex. ValidateAssetNameHex accepts uppercase because
+// hex.DecodeString does, while AssetNameHex always emits lowercase, so an
+// uppercase input does not round-trip to the same string. Use the strict
+// form where identifiers are compared or stored as strings.
+// Returns ErrInvalidHex if the input is not canonical hex, or
+// ErrAssetNameTooLong if it decodes to more than 32 bytes.
+//
+// Example:
+//
+//	err := cardanoasset.ValidateAssetNameHexStrict("537061636542756430") // nil
+//	err = cardanoasset.ValidateAssetNameHexStrict("53706163654275643A") // ErrInvalidHex
+func ValidateAssetNameHexStrict(assetNameHex string) error {
+	if !IsCanonicalHex(assetNameHex) {
+		return fmt.Errorf("%w: not canonical lowercase hex", ErrInvalidHex)
+	}
+	if len(assetNameHex)/2 > MaxAssetNameLength {
+		return ErrAssetNameTooLong
+	}
+	return nil
+}
+
+// IsCanonicalHex reports whether s is canonical hex as emitted by this
+// package: even length and only the characters 0-9 and a-f. The empty
+// string is canonical.
+//
+// Example:
 //
-// NOTE: For production CIP-14 fingerprints, this uses SHA-256 truncated to
-// 20 bytes. If you need exact CIP-14 compatibility with the reference
-// implementation (which uses blake2b-160), integrate golang.org/x/crypto/blake2b.
-// This package is designed to be dependency-free; a build tag can swap the hasher.
-func blake2b160(data []byte) []byte {
-	h := sha256.Sum256(data)
-	return h[:20]
-}
\ No newline at end of file
+//	cardanoasset.IsCanonicalHex("537061636542756430") // true
+//	cardanoasset.IsCanonicalHex("5370616365427564AB") // false
+func IsCanonicalHex(s string) bool {
+	if len(s)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f')) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexDigit reports whether c is a hexadecimal digit of either case.
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,1205 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// testPolicy is the SpaceBudz policy used throughout the doc examples.
+const testPolicy = "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc"
+
+// cip14Vectors are the reference vectors from the CIP-14 specification.
+var cip14Vectors = []struct {
+	policyID     string
+	assetNameHex string
+	fingerprint  string
+}{
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "", "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc37e", "", "asset1nl0puwxmhas8fawxp8nx4e2q3wekg969n2auw3"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "", "asset1uyuxku60yqe57nusqzjx38aan3f2wq6s93f6ea"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "504154415445", "asset13n25uv0yaf5kus35fm2k86cqy60z58d9xmde92"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "504154415445", "asset1hv4p5tv2a837mzqrst04d0dcptdjmluqvdx9k3"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "asset1aqrdypg669jgazruv5ah07nuyqe0wxjhe2el6f"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "asset17jd78wukhtrnmjh3fngzasxm8rck0l2r4hhyyt"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "0000000000000000000000000000000000000000000000000000000000000000", "asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w"},
+}
+
+// mustPanic runs f and fails the test if it does not panic.
+func mustPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	f()
+}
+
+func TestMustFingerprint(t *testing.T) {
+	for _, v := range cip14Vectors {
+		t.Run(v.fingerprint, func(t *testing.T) {
+			a, err := NewAssetFromHex(v.policyID, v.assetNameHex)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := MustFingerprint(a.PolicyID, a.AssetName); got != v.fingerprint {
+				t.Errorf("MustFingerprint = %s, want %s", got, v.fingerprint)
+			}
+		})
+	}
+}
+
+func TestMustPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{name: "MustFingerprint bad policy", f: func() { MustFingerprint("xyz", "a") }},
+		{name: "MustFingerprint long name", f: func() { MustFingerprint(testPolicy, string(make([]byte, 33))) }},
+		{name: "MustNewAsset bad policy", f: func() { MustNewAsset("xyz", "a") }},
+		{name: "MustNewAsset long name", f: func() { MustNewAsset(testPolicy, string(make([]byte, 33))) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mustPanic(t, tt.f)
+		})
+	}
+}
+
+func TestMustNewAsset(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+	}{
+		{name: "text name", assetName: "SpaceBud0"},
+		{name: "empty name", assetName: ""},
+		{name: "32-byte name", assetName: "12345678901234567890123456789012"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			if got := MustNewAsset(testPolicy, tt.assetName); got != want {
+				t.Errorf("MustNewAsset = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestNameAsTxHash(t *testing.T) {
+	hash := "8f5c2c2f1a9f2b73b0d24ad3a1e0c8c94bd0c8d3f6e0e6f1b1e2a3b4c5d6e7f8"
+	tests := []struct {
+		name   string
+		asset  Asset
+		want   string
+		wantOK bool
+	}{
+		{name: "32-byte name", asset: mustAssetFromHex(t, testPolicy, hash), want: hash, wantOK: true},
+		{name: "shorter name", asset: MustNewAsset(testPolicy, "SpaceBud0")},
+		{name: "empty name", asset: MustNewAsset(testPolicy, "")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.asset.NameAsTxHash()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("NameAsTxHash() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// mustAssetFromHex is NewAssetFromHex for known-good test input.
+func mustAssetFromHex(t *testing.T, policyID, assetNameHex string) Asset {
+	t.Helper()
+	a, err := NewAssetFromHex(policyID, assetNameHex)
+	if err != nil {
+		t.Fatalf("NewAssetFromHex(%q, %q): %v", policyID, assetNameHex, err)
+	}
+	return a
+}
+
+func TestAssetTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		asset Asset
+		text  string
+	}{
+		{name: "text name", asset: MustNewAsset(testPolicy, "SpaceBud0"), text: testPolicy + ".537061636542756430"},
+		{name: "empty name", asset: MustNewAsset(testPolicy, ""), text: testPolicy},
+		{name: "binary name", asset: mustAssetFromHex(t, testPolicy, "000de1404e4654"), text: testPolicy + ".000de1404e4654"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m encoding.TextMarshaler = tt.asset
+			text, err := m.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != tt.text {
+				t.Errorf("MarshalText() = %s, want %s", text, tt.text)
+			}
+			var got Asset
+			var u encoding.TextUnmarshaler = &got
+			if err := u.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.asset {
+				t.Errorf("UnmarshalText() = %+v, want %+v", got, tt.asset)
+			}
+		})
+	}
+}
+
+func TestAssetUnmarshalTextErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr error
+	}{
+		{name: "bad policy", text: "xyz.00", wantErr: ErrInvalidPolicyID},
+		{name: "bad name hex", text: testPolicy + ".zz", wantErr: ErrInvalidHex},
+		{name: "empty", text: "", wantErr: ErrInvalidAssetID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Asset
+			if err := a.UnmarshalText([]byte(tt.text)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalText(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsDNSSafeName(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+		want      bool
+	}{
+		{name: "valid handle", assetName: "alice", want: true},
+		{name: "digits and hyphen", assetName: "bud-0", want: true},
+		{name: "cip-68 labeled handle", assetName: "\x00\x0d\xe1\x40alice", want: true},
+		{name: "uppercase", assetName: "Alice", want: false},
+		{name: "underscore", assetName: "al_ice", want: false},
+		{name: "dot", assetName: "al.ice", want: false},
+		{name: "leading hyphen", assetName: "-alice", want: false},
+		{name: "trailing hyphen", assetName: "alice-", want: false},
+		{name: "empty", assetName: "", want: false},
+		{name: "label only", assetName: "\x00\x0d\xe1\x40", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			if got := a.IsDNSSafeName(); got != tt.want {
+				t.Errorf("IsDNSSafeName(%q) = %v, want %v", tt.assetName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFingerprintBoundaryCheck(t *testing.T) {
+	const policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	tests := []struct {
+		name        string
+		policyID    string
+		assetName   string
+		wantStrict  string
+		wantLenient string
+		wantWithin  bool
+		wantErr     error
+	}{
+		{
+			name:        "empty name",
+			policyID:    policy,
+			wantStrict:  "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+			wantLenient: "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+			wantWithin:  true,
+		},
+		{
+			name:        "exactly 32 bytes",
+			policyID:    policy,
+			assetName:   strings.Repeat("\x00", 32),
+			wantStrict:  "asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w",
+			wantLenient: "asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w",
+			wantWithin:  true,
+		},
+		{
+			name:        "33 bytes",
+			policyID:    policy,
+			assetName:   strings.Repeat("\x00", 33),
+			wantLenient: "asset1fpxyd60k3eg6ga9aswcaqnz8mus80xfftl588y",
+		},
+		{name: "invalid policy", policyID: "abcd", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strict, lenient, within, err := FingerprintBoundaryCheck(tt.policyID, tt.assetName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintBoundaryCheck error = %v, want %v", err, tt.wantErr)
+			}
+			if strict != tt.wantStrict || lenient != tt.wantLenient || within != tt.wantWithin {
+				t.Errorf("FingerprintBoundaryCheck = (%q, %q, %v), want (%q, %q, %v)",
+					strict, lenient, within, tt.wantStrict, tt.wantLenient, tt.wantWithin)
+			}
+		})
+	}
+}
+
+func TestFingerprintWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		h         func([]byte) []byte
+		policyID  string
+		assetName string
+		want      string
+		wantErr   error
+	}{
+		{
+			name:     "blake2b-160 matches cip-14",
+			h:        blake2b160,
+			policyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+			want:     "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+		},
+		{
+			name:     "truncated blake2b-256 is not blake2b-160",
+			h:        func(b []byte) []byte { return blake2b256(b)[:20] },
+			policyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+		},
+		{
+			name:     "short digest",
+			h:        func(b []byte) []byte { return blake2b256(b)[:19] },
+			policyID: testPolicy,
+			wantErr:  ErrInvalidHashLength,
+		},
+		{
+			name:     "long digest",
+			h:        blake2b256,
+			policyID: testPolicy,
+			wantErr:  ErrInvalidHashLength,
+		},
+		{
+			name:     "invalid policy",
+			h:        blake2b160,
+			policyID: "abcd",
+			wantErr:  ErrInvalidPolicyID,
+		},
+		{
+			name:      "name too long",
+			h:         blake2b160,
+			policyID:  testPolicy,
+			assetName: strings.Repeat("x", MaxAssetNameLength+1),
+			wantErr:   ErrAssetNameTooLong,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FingerprintWith(tt.h, tt.policyID, tt.assetName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintWith error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			std, _ := Fingerprint(tt.policyID, tt.assetName)
+			if tt.want != "" && got != tt.want {
+				t.Errorf("FingerprintWith = %s, want %s", got, tt.want)
+			}
+			if tt.want == "" && got == std {
+				t.Errorf("FingerprintWith = %s, want it to differ from Fingerprint", got)
+			}
+		})
+	}
+}
+
+func TestGlobalSortKey(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	assets := []Asset{
+		{PolicyID: testPolicy, AssetName: "SpaceBud1"},
+		{PolicyID: otherPolicy, AssetName: "PATATE"},
+		{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+		{PolicyID: otherPolicy},
+		{PolicyID: testPolicy, AssetName: "SpaceBud2"},
+	}
+	infos := make([]AssetInfo, len(assets))
+	for i, a := range assets {
+		info, err := a.Info()
+		if err != nil {
+			t.Fatalf("Info(%v) error = %v", a, err)
+		}
+		infos[i] = info
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].GlobalSortKey() < infos[j].GlobalSortKey() })
+
+	tests := []struct {
+		name string
+		less func(a, b AssetInfo) bool
+	}{
+		{name: "grouped by policy", less: func(a, b AssetInfo) bool { return a.PolicyID <= b.PolicyID }},
+		{name: "fingerprint order within policy", less: func(a, b AssetInfo) bool {
+			return a.PolicyID != b.PolicyID || a.Fingerprint < b.Fingerprint
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 1; i < len(infos); i++ {
+				if !tt.less(infos[i-1], infos[i]) {
+					t.Errorf("infos[%d] (%s) sorted before infos[%d] (%s)", i-1, infos[i-1].GlobalSortKey(), i, infos[i].GlobalSortKey())
+				}
+			}
+		})
+	}
+	if got := infos[0].GlobalSortKey(); got != infos[0].PolicyID+infos[0].Fingerprint {
+		t.Errorf("GlobalSortKey() = %q, want policy ID + fingerprint", got)
+	}
+}
+
+func TestAssetValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		asset   Asset
+		wantErr error
+	}{
+		{name: "valid", asset: Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}},
+		{name: "empty name", asset: Asset{PolicyID: testPolicy}},
+		{name: "32-byte name", asset: Asset{PolicyID: testPolicy, AssetName: strings.Repeat("x", MaxAssetNameLength)}},
+		{name: "bad policy", asset: Asset{PolicyID: "not-a-policy", AssetName: "SpaceBud0"}, wantErr: ErrInvalidPolicyID},
+		{name: "uppercase policy", asset: Asset{PolicyID: "D5E6BF0500378D4F0DA4E8DDE6BECEC7621CD8CBF5CBB9B87013D4CC"}, wantErr: ErrInvalidPolicyID},
+		{name: "over-long name", asset: Asset{PolicyID: testPolicy, AssetName: strings.Repeat("x", MaxAssetNameLength+1)}, wantErr: ErrAssetNameTooLong},
+		{name: "zero asset", asset: Asset{}, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.asset.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestEmptyNameFingerprint pins the CIP-14 policy-only vector: an empty name
+// hashes only the 28 policy bytes, whichever constructor built the asset.
+func TestEmptyNameFingerprint(t *testing.T) {
+	const (
+		policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+		want   = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	)
+	tests := []struct {
+		name  string
+		build func() (Asset, error)
+	}{
+		{name: "NewAsset", build: func() (Asset, error) { return NewAsset(policy, "") }},
+		{name: "NewAssetFromHex", build: func() (Asset, error) { return NewAssetFromHex(policy, "") }},
+		{name: "ParseAssetID", build: func() (Asset, error) { return ParseAssetID(policy) }},
+		{name: "ParseUnit", build: func() (Asset, error) { return ParseUnit(policy) }},
+		{name: "struct literal", build: func() (Asset, error) { return Asset{PolicyID: policy}, nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := tt.build()
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			if a.AssetName != "" {
+				t.Fatalf("AssetName = %q, want empty", a.AssetName)
+			}
+			got, err := a.Fingerprint()
+			if err != nil {
+				t.Fatalf("Fingerprint() error = %v", err)
+			}
+			if got != want {
+				t.Errorf("Fingerprint() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		policyID string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "cip-14 policy-only vector",
+			policyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+			want:     "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+		},
+		{
+			name:     "second policy-only vector",
+			policyID: "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209",
+			want:     "asset1uyuxku60yqe57nusqzjx38aan3f2wq6s93f6ea",
+		},
+		{name: "invalid policy", policyID: "abcd", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := PolicyDefaultAsset(tt.policyID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PolicyDefaultAsset error = %v, want %v", err, tt.wantErr)
+			}
+			fp, err := PolicyDefaultFingerprint(tt.policyID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PolicyDefaultFingerprint error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if a != (Asset{PolicyID: tt.policyID}) {
+				t.Errorf("PolicyDefaultAsset = %+v, want empty name under %s", a, tt.policyID)
+			}
+			if fp != tt.want {
+				t.Errorf("PolicyDefaultFingerprint = %s, want %s", fp, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetBinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		asset   Asset
+		wantLen int
+	}{
+		{name: "empty name", asset: Asset{PolicyID: testPolicy}, wantLen: 29},
+		{name: "SpaceBud0", asset: Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}, wantLen: 38},
+		{name: "32-byte name", asset: Asset{PolicyID: testPolicy, AssetName: strings.Repeat("\xff", 32)}, wantLen: 61},
+		{name: "cip-68 name", asset: Asset{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40NFT"}, wantLen: 36},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.asset.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			if len(b) != tt.wantLen {
+				t.Errorf("len(MarshalBinary()) = %d, want %d", len(b), tt.wantLen)
+			}
+			var got Asset
+			if err := got.UnmarshalBinary(b); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if got != tt.asset {
+				t.Errorf("UnmarshalBinary(MarshalBinary()) = %+v, want %+v", got, tt.asset)
+			}
+
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tt.asset); err != nil {
+				t.Fatalf("gob Encode error = %v", err)
+			}
+			var viaGob Asset
+			if err := gob.NewDecoder(&buf).Decode(&viaGob); err != nil {
+				t.Fatalf("gob Decode error = %v", err)
+			}
+			if viaGob != tt.asset {
+				t.Errorf("gob round trip = %+v, want %+v", viaGob, tt.asset)
+			}
+		})
+	}
+}
+
+func TestAssetInfoGobRoundTrip(t *testing.T) {
+	for _, nameHex := range []string{"537061636542756430", "000de1404e4654", ""} {
+		t.Run(nameHex, func(t *testing.T) {
+			info, err := mustAssetFromHex(t, testPolicy, nameHex).Info()
+			if err != nil {
+				t.Fatalf("Info() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(info); err != nil {
+				t.Fatalf("gob Encode error = %v", err)
+			}
+			var got AssetInfo
+			if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+				t.Fatalf("gob Decode error = %v", err)
+			}
+			if got != info {
+				t.Errorf("gob round trip = %+v, want %+v", got, info)
+			}
+		})
+	}
+
+	var info AssetInfo
+	if err := info.UnmarshalBinary([]byte{1, 2, 3}); !errors.Is(err, ErrInvalidBinary) {
+		t.Errorf("UnmarshalBinary(short) error = %v, want %v", err, ErrInvalidBinary)
+	}
+}
+
+func TestAssetBinaryErrors(t *testing.T) {
+	valid, err := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tooLong := append(append([]byte{}, valid[:PolicyIDLength]...), 33)
+	tooLong = append(tooLong, make([]byte, 33)...)
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "empty", data: nil, wantErr: ErrInvalidBinary},
+		{name: "truncated policy", data: valid[:20], wantErr: ErrInvalidBinary},
+		{name: "truncated name", data: valid[:len(valid)-1], wantErr: ErrInvalidBinary},
+		{name: "trailing bytes", data: append(append([]byte{}, valid...), 0), wantErr: ErrInvalidBinary},
+		{name: "name over 32 bytes", data: tooLong, wantErr: ErrAssetNameTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Asset
+			if err := a.UnmarshalBinary(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalBinary() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	t.Run("marshal invalid asset", func(t *testing.T) {
+		if _, err := (Asset{PolicyID: "abcd"}).MarshalBinary(); !errors.Is(err, ErrInvalidPolicyID) {
+			t.Errorf("MarshalBinary() error = %v, want %v", err, ErrInvalidPolicyID)
+		}
+	})
+}
+
+func TestPolicyIDEqual(t *testing.T) {
+	const other = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "equal", a: testPolicy, b: testPolicy, want: true},
+		{name: "unequal", a: testPolicy, b: other, want: false},
+		{name: "last byte differs", a: testPolicy, b: testPolicy[:54] + "cd", want: false},
+		{name: "first invalid", a: "abcd", b: testPolicy, want: false},
+		{name: "second invalid", a: testPolicy, b: "not-a-policy", want: false},
+		{name: "uppercase copy", a: testPolicy, b: strings.ToUpper(testPolicy), want: false},
+		{name: "both empty", a: "", b: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PolicyIDEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("PolicyIDEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		unit       string
+		wantPolicy string
+		wantName   string
+		wantOK     bool
+	}{
+		{name: "with name", unit: testPolicy + "537061636542756430", wantPolicy: testPolicy, wantName: "537061636542756430", wantOK: true},
+		{name: "bare policy", unit: testPolicy, wantPolicy: testPolicy, wantOK: true},
+		{name: "not validated", unit: strings.Repeat("Z", 56) + "zz", wantPolicy: strings.Repeat("Z", 56), wantName: "zz", wantOK: true},
+		{name: "odd name length", unit: testPolicy + "537", wantOK: false},
+		{name: "too short", unit: testPolicy[:55], wantOK: false},
+		{name: "empty", unit: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, name, ok := SplitUnit(tt.unit)
+			if policy != tt.wantPolicy || name != tt.wantName || ok != tt.wantOK {
+				t.Errorf("SplitUnit(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.unit, policy, name, ok, tt.wantPolicy, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
+
+func BenchmarkSplitUnit(b *testing.B) {
+	const unit = testPolicy + "537061636542756430"
+	b.Run("SplitUnit", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, ok := SplitUnit(unit); !ok {
+				b.Fatal("SplitUnit failed")
+			}
+		}
+	})
+	b.Run("ParseUnit", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseUnit(unit); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestNameBytes(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+	}{
+		{name: "ascii", assetName: "SpaceBud0"},
+		{name: "binary", assetName: "\x00\x0d\xe1\x40NFT"},
+		{name: "empty", assetName: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			b := a.NameBytes()
+			if string(b) != tt.assetName {
+				t.Fatalf("NameBytes() = %x, want %x", b, tt.assetName)
+			}
+			for i := range b {
+				b[i] ^= 0xff
+			}
+			if a.AssetName != tt.assetName {
+				t.Errorf("mutating NameBytes() changed AssetName to %q", a.AssetName)
+			}
+			if again := a.NameBytes(); string(again) != tt.assetName {
+				t.Errorf("second NameBytes() = %x, want %x", again, tt.assetName)
+			}
+		})
+	}
+}
+
+func TestMatchesIdentifier(t *testing.T) {
+	v := cip14Vectors[3] // PATATE under 7eae28af...
+	a := mustAssetFromHex(t, v.policyID, v.assetNameHex)
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "asset id", id: v.policyID + "." + v.assetNameHex, want: true},
+		{name: "unit", id: v.policyID + v.assetNameHex, want: true},
+		{name: "fingerprint", id: v.fingerprint, want: true},
+		{name: "other fingerprint", id: cip14Vectors[0].fingerprint, want: false},
+		{name: "bare policy", id: v.policyID, want: false},
+		{name: "uppercase unit", id: strings.ToUpper(v.policyID + v.assetNameHex), want: false},
+		{name: "raw name", id: "PATATE", want: false},
+		{name: "empty", id: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.MatchesIdentifier(tt.id); got != tt.want {
+				t.Errorf("MatchesIdentifier(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+	t.Run("empty name bare policy", func(t *testing.T) {
+		p := Asset{PolicyID: cip14Vectors[0].policyID}
+		for _, id := range []string{p.PolicyID, cip14Vectors[0].fingerprint} {
+			if !p.MatchesIdentifier(id) {
+				t.Errorf("MatchesIdentifier(%q) = false, want true", id)
+			}
+		}
+	})
+}
+
+func TestParseAssetIDOddNameHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		assetID string
+	}{
+		{name: "one char", assetID: testPolicy + ".5"},
+		{name: "odd valid hex", assetID: testPolicy + ".53706"},
+		{name: "odd invalid hex", assetID: testPolicy + ".zzz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAssetID(tt.assetID)
+			if !errors.Is(err, ErrInvalidAssetID) {
+				t.Fatalf("ParseAssetID(%q) error = %v, want %v", tt.assetID, err, ErrInvalidAssetID)
+			}
+			if errors.Is(err, ErrInvalidHex) {
+				t.Errorf("ParseAssetID(%q) error = %v, should not wrap %v", tt.assetID, err, ErrInvalidHex)
+			}
+			if !strings.Contains(err.Error(), "odd length") {
+				t.Errorf("ParseAssetID(%q) error = %q, want it to mention odd length", tt.assetID, err)
+			}
+			var ve *ValidationError
+			if !errors.As(err, &ve) || ve.Field != "assetId" {
+				t.Errorf("ParseAssetID(%q) error = %#v, want *ValidationError for assetId", tt.assetID, err)
+			}
+		})
+	}
+	t.Run("even invalid hex", func(t *testing.T) {
+		if _, err := ParseAssetID(testPolicy + ".zz"); !errors.Is(err, ErrInvalidHex) {
+			t.Errorf("ParseAssetID error = %v, want %v", err, ErrInvalidHex)
+		}
+	})
+}
+
+func TestWithName(t *testing.T) {
+	base := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name    string
+		derive  func() (Asset, error)
+		want    string
+		wantErr error
+	}{
+		{name: "WithName", derive: func() (Asset, error) { return base.WithName("SpaceBud1") }, want: "SpaceBud1"},
+		{name: "WithName empty", derive: func() (Asset, error) { return base.WithName("") }, want: ""},
+		{name: "WithNameHex", derive: func() (Asset, error) { return base.WithNameHex("537061636542756431") }, want: "SpaceBud1"},
+		{name: "WithName too long", derive: func() (Asset, error) { return base.WithName(strings.Repeat("x", 33)) }, wantErr: ErrAssetNameTooLong},
+		{name: "WithNameHex too long", derive: func() (Asset, error) { return base.WithNameHex(strings.Repeat("00", 33)) }, wantErr: ErrAssetNameTooLong},
+		{name: "WithNameHex bad hex", derive: func() (Asset, error) { return base.WithNameHex("zz") }, wantErr: ErrInvalidHex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.derive()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if base.AssetName != "SpaceBud0" {
+				t.Fatalf("receiver name changed to %q", base.AssetName)
+			}
+			if err != nil {
+				return
+			}
+			if got.PolicyID != testPolicy || got.AssetName != tt.want {
+				t.Errorf("got %s/%q, want %s/%q", got.PolicyID, got.AssetName, testPolicy, tt.want)
+			}
+		})
+	}
+}
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestAssetInfoJSONGolden(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		golden  string
+	}{
+		{name: "printable name", nameHex: "537061636542756430", golden: "asset_info_spacebud0.golden"},
+		{name: "cip-68 binary name", nameHex: "000de1404e4654", golden: "asset_info_cip68.golden"},
+		{name: "empty name", nameHex: "", golden: "asset_info_empty_name.golden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := mustAssetFromHex(t, testPolicy, tt.nameHex).Info()
+			if err != nil {
+				t.Fatalf("Info() error = %v", err)
+			}
+			got, err := info.JSON()
+			if err != nil {
+				t.Fatalf("JSON() error = %v", err)
+			}
+			got = append(got, '\n')
+			path := filepath.Join("testdata", tt.golden)
+			if *updateGolden {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("JSON() =\n%s\nwant\n%s", got, want)
+			}
+			if bytes.ContainsRune(got, utf8.RuneError) || bytes.Contains(got, []byte(`\ufffd`)) {
+				t.Errorf("JSON() contains a replacement character: %s", got)
+			}
+		})
+	}
+}
+
+func TestAssetInfoEncodingJSON(t *testing.T) {
+	for _, nameHex := range []string{"537061636542756430", "000de1404e4654", ""} {
+		t.Run(nameHex, func(t *testing.T) {
+			info, err := mustAssetFromHex(t, testPolicy, nameHex).Info()
+			if err != nil {
+				t.Fatalf("Info() error = %v", err)
+			}
+			body, err := json.Marshal(info)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var fields assetInfoJSON
+			if err := json.Unmarshal(body, &fields); err != nil {
+				t.Fatalf("json.Marshal() = %s, not an object: %v", body, err)
+			}
+			wantName := info.AssetName
+			if !isPrintableName(wantName) {
+				wantName = ""
+			}
+			want := assetInfoJSON{
+				PolicyID:     testPolicy,
+				AssetName:    wantName,
+				AssetNameHex: nameHex,
+				Fingerprint:  info.Fingerprint,
+				AssetID:      info.AssetID,
+				Unit:         info.Unit(),
+			}
+			if fields != want {
+				t.Errorf("json.Marshal() fields = %+v, want %+v", fields, want)
+			}
+
+			var got AssetInfo
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got != info {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, info)
+			}
+		})
+	}
+}
+
+func TestAssetInfoUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "bad policy", body: `{"policyId":"abc","assetNameHex":""}`, wantErr: ErrInvalidPolicyID},
+		{name: "bad name hex", body: `{"policyId":"` + testPolicy + `","assetNameHex":"zz"}`, wantErr: ErrInvalidHex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info AssetInfo
+			if err := json.Unmarshal([]byte(tt.body), &info); !errors.Is(err, tt.wantErr) {
+				t.Errorf("json.Unmarshal() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAssetIDSep(t *testing.T) {
+	want := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name    string
+		assetID string
+		sep     byte
+		want    Asset
+		wantErr error
+	}{
+		{name: "colon", assetID: testPolicy + ":537061636542756430", sep: ':', want: want},
+		{name: "dot", assetID: testPolicy + ".537061636542756430", sep: '.', want: want},
+		{name: "non-ascii byte", assetID: testPolicy + "\xb7537061636542756430", sep: 0xb7, want: want},
+		{name: "empty name", assetID: testPolicy, sep: ':', want: Asset{PolicyID: testPolicy}},
+		{name: "wrong separator", assetID: testPolicy + ".537061636542756430", sep: ':', wantErr: ErrInvalidPolicyID},
+		{name: "hex digit separator", assetID: testPolicy + "a537061636542756430", sep: 'a', wantErr: ErrInvalidSeparator},
+		{name: "upper hex digit separator", assetID: testPolicy + "F537061636542756430", sep: 'F', wantErr: ErrInvalidSeparator},
+		{name: "digit separator", assetID: testPolicy + "0537061636542756430", sep: '0', wantErr: ErrInvalidSeparator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAssetIDSep(tt.assetID, tt.sep)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAssetIDSep(%q, %q) error = %v, want %v", tt.assetID, tt.sep, err, tt.wantErr)
+			}
+			if err != nil {
+				var ve *ValidationError
+				if !errors.As(err, &ve) {
+					t.Errorf("ParseAssetIDSep() error = %#v, want *ValidationError", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseAssetIDSep() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAssetIDSepErrorContext(t *testing.T) {
+	_, err := ParseAssetIDSep(testPolicy+"a537061636542756430", 'a')
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("ParseAssetIDSep() error = %#v, want *ValidationError", err)
+	}
+	if ve.Field != "sep" || ve.Value != "a" {
+		t.Errorf("ValidationError = {Field: %q, Value: %q}, want {Field: \"sep\", Value: \"a\"}", ve.Field, ve.Value)
+	}
+}
+
+func TestAssetIDUnitRoundTrip(t *testing.T) {
+	assets := make([]Asset, 0, len(cip14Vectors)+4)
+	for _, v := range cip14Vectors {
+		assets = append(assets, mustAssetFromHex(t, v.policyID, v.assetNameHex))
+	}
+	assets = append(assets,
+		Asset{PolicyID: testPolicy},
+		Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+		Asset{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40Bud"},
+		Asset{PolicyID: testPolicy, AssetName: strings.Repeat("\xff", MaxAssetNameLength)},
+	)
+	for _, a := range assets {
+		t.Run(a.AssetID(), func(t *testing.T) {
+			fromUnit, err := ParseUnit(a.Unit())
+			if err != nil {
+				t.Fatalf("ParseUnit(%q) error = %v", a.Unit(), err)
+			}
+			if fromUnit != a {
+				t.Errorf("ParseUnit(a.Unit()) = %+v, want %+v", fromUnit, a)
+			}
+			fromID, err := ParseAssetID(a.AssetID())
+			if err != nil {
+				t.Fatalf("ParseAssetID(%q) error = %v", a.AssetID(), err)
+			}
+			if fromID != a {
+				t.Errorf("ParseAssetID(a.AssetID()) = %+v, want %+v", fromID, a)
+			}
+			if a.AssetName == "" {
+				if a.Unit() != a.PolicyID || a.AssetID() != a.PolicyID {
+					t.Errorf("empty name: Unit() = %q, AssetID() = %q, want both %q", a.Unit(), a.AssetID(), a.PolicyID)
+				}
+				return
+			}
+			if want := a.PolicyID + "." + a.AssetNameHex(); a.AssetID() != want {
+				t.Errorf("AssetID() = %q, want %q", a.AssetID(), want)
+			}
+			if want := a.PolicyID + a.AssetNameHex(); a.Unit() != want {
+				t.Errorf("Unit() = %q, want %q", a.Unit(), want)
+			}
+		})
+	}
+}
+
+func TestIsCanonicalHex(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{name: "lowercase", in: "537061636542756430", want: true},
+		{name: "empty", in: "", want: true},
+		{name: "uppercase", in: "5370616365427564AB", want: false},
+		{name: "odd length", in: "abc", want: false},
+		{name: "non-hex", in: "zz", want: false},
+		{name: "prefixed", in: "0x53", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCanonicalHex(tt.in); got != tt.want {
+				t.Errorf("IsCanonicalHex(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAssetNameHexStrict(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		wantErr       error
+		wantStrictErr error
+	}{
+		{name: "lowercase", in: "537061636542756430"},
+		{name: "empty", in: ""},
+		{name: "uppercase", in: "53706163654275643A", wantStrictErr: ErrInvalidHex},
+		{name: "invalid hex", in: "zz", wantErr: ErrInvalidHex, wantStrictErr: ErrInvalidHex},
+		{name: "too long", in: strings.Repeat("ab", MaxAssetNameLength+1), wantErr: ErrAssetNameTooLong, wantStrictErr: ErrAssetNameTooLong},
+		{name: "at limit", in: strings.Repeat("ab", MaxAssetNameLength)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAssetNameHex(tt.in); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateAssetNameHex(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if err := ValidateAssetNameHexStrict(tt.in); !errors.Is(err, tt.wantStrictErr) {
+				t.Errorf("ValidateAssetNameHexStrict(%q) error = %v, want %v", tt.in, err, tt.wantStrictErr)
+			}
+		})
+	}
+}
+
+func TestParseAnnotatedAssetID(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name     string
+		in       string
+		want     Asset
+		wantKind string
+		wantErr  error
+	}{
+		{name: "nft annotation", in: testPolicy + ".nft:537061636542756430", want: bud0, wantKind: "nft"},
+		{name: "ft annotation", in: testPolicy + ".ft:537061636542756430", want: bud0, wantKind: "ft"},
+		{name: "plain", in: testPolicy + ".537061636542756430", want: bud0},
+		{name: "bare policy", in: testPolicy, want: Asset{PolicyID: testPolicy}},
+		{name: "unknown annotation", in: testPolicy + ".rft:5370", wantErr: ErrInvalidHex},
+		{name: "annotation only validated name", in: testPolicy + ".nft:zz", wantErr: ErrInvalidHex},
+		{name: "annotation before policy", in: "nft:" + testPolicy + ".5370", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, kind, err := ParseAnnotatedAssetID(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAnnotatedAssetID(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want || kind != tt.wantKind {
+				t.Errorf("ParseAnnotatedAssetID(%q) = (%+v, %q), want (%+v, %q)", tt.in, got, kind, tt.want, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyIDDetailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		policyID string
+		wantErr  bool
+		wantHint string
+	}{
+		{name: "valid", policyID: testPolicy},
+		{name: "transaction hash", policyID: strings.Repeat("ab", 32), wantErr: true, wantHint: "got 64 characters; this looks like a transaction hash"},
+		{name: "key hash", policyID: strings.Repeat("ab", 20), wantErr: true, wantHint: "got 40 characters; this looks like a 20-byte key hash"},
+		{name: "other length", policyID: "abcd", wantErr: true, wantHint: "got 4 characters"},
+		{name: "uppercase", policyID: strings.ToUpper(testPolicy), wantErr: true, wantHint: "contains uppercase hex"},
+		{name: "non-hex", policyID: testPolicy[:10] + "z" + testPolicy[11:], wantErr: true, wantHint: `non-hex character 'z' at offset 10`},
+		{name: "empty", policyID: "", wantErr: true, wantHint: "got 0 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePolicyIDDetailed(tt.policyID)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("ValidatePolicyIDDetailed() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidPolicyID) {
+				t.Fatalf("ValidatePolicyIDDetailed() error = %v, want %v", err, ErrInvalidPolicyID)
+			}
+			if !strings.Contains(err.Error(), tt.wantHint) {
+				t.Errorf("ValidatePolicyIDDetailed() error = %q, want it to contain %q", err, tt.wantHint)
+			}
+		})
+	}
+}
+
+func TestBundleKey(t *testing.T) {
+	assets := []Asset{
+		{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+		{PolicyID: testPolicy},
+		{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40NFT"},
+		{PolicyID: testPolicy, AssetName: strings.Repeat("x", MaxAssetNameLength)},
+	}
+	for _, v := range cip14Vectors {
+		assets = append(assets, mustAssetFromHex(t, v.policyID, v.assetNameHex))
+	}
+	for _, a := range assets {
+		t.Run(a.AssetID(), func(t *testing.T) {
+			key := a.BundleKey()
+			if len(key) != PolicyIDLength+len(a.AssetName) {
+				t.Fatalf("len(BundleKey()) = %d, want %d", len(key), PolicyIDLength+len(a.AssetName))
+			}
+			if got := hex.EncodeToString(key[:PolicyIDLength]); got != a.PolicyID {
+				t.Errorf("BundleKey() policy bytes = %s, want %s", got, a.PolicyID)
+			}
+			if got := string(key[PolicyIDLength:]); got != a.AssetName {
+				t.Errorf("BundleKey() name bytes = %q, want %q", got, a.AssetName)
+			}
+			data, err := ConvertBits(blake2b160(key), 8, 5, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromKey, err := Bech32Encode(fingerprintHRP, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, _ := a.Fingerprint(); fromKey != want {
+				t.Errorf("fingerprint of BundleKey() = %s, want %s", fromKey, want)
+			}
+		})
+	}
+
+	t.Run("invalid policy", func(t *testing.T) {
+		if key := (Asset{PolicyID: "zz", AssetName: "x"}).BundleKey(); key != nil {
+			t.Errorf("BundleKey() = %x, want nil", key)
+		}
+	})
+}
+
+func TestFingerprintUnchecked(t *testing.T) {
+	// Expected fingerprints computed independently with Python's hashlib.
+	tests := []struct {
+		name       string
+		policyID   string
+		assetName  string
+		want       string
+		wantErr    error
+		wantStrict error
+	}{
+		{name: "40-byte name", policyID: testPolicy, assetName: strings.Repeat("x", 40), want: "asset1hzl50drrvrcep79htf8t000l4g39fatpx2z6jp", wantStrict: ErrAssetNameTooLong},
+		{name: "32-byte name matches Fingerprint", policyID: testPolicy, assetName: strings.Repeat("x", 32), want: "asset1ze9pgtrezkchuhjllvvxf7ryf9jtzud4cu7r47"},
+		{name: "short name matches Fingerprint", policyID: testPolicy, assetName: "SpaceBud0", want: "asset1rhmwfllvhgczltxm0y7rdump6g5p5ax4c25csq"},
+		{name: "policy still validated", policyID: "abcd", assetName: strings.Repeat("x", 40), wantErr: ErrInvalidPolicyID, wantStrict: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FingerprintUnchecked(tt.policyID, tt.assetName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintUnchecked() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FingerprintUnchecked() = %s, want %s", got, tt.want)
+			}
+			strict, err := Fingerprint(tt.policyID, tt.assetName)
+			if !errors.Is(err, tt.wantStrict) {
+				t.Fatalf("Fingerprint() error = %v, want %v", err, tt.wantStrict)
+			}
+			if err == nil && strict != got {
+				t.Errorf("Fingerprint() = %s, FingerprintUnchecked() = %s, want equal", strict, got)
+			}
+		})
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	tests := []struct {
+		name  string
+		asset Asset
+		want  bool
+	}{
+		{name: "zero value", asset: Asset{}, want: true},
+		{name: "lovelace key", asset: Lovelace, want: true},
+		{name: "empty-name asset", asset: Asset{PolicyID: testPolicy}, want: false},
+		{name: "named asset", asset: Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}, want: false},
+		{name: "name without policy", asset: Asset{AssetName: "SpaceBud0"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.asset.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAssetIDFingerprint(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{name: "fingerprint", in: fp, wantErr: ErrIsFingerprint},
+		{name: "uppercase fingerprint", in: strings.ToUpper(fp), wantErr: ErrIsFingerprint},
+		{name: "corrupted fingerprint", in: fp[:len(fp)-1] + "q", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAssetID(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAssetID(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if tt.wantErr == ErrIsFingerprint && errors.Is(err, ErrInvalidAssetID) {
+				t.Errorf("ParseAssetID(%q) error = %v, should not wrap %v", tt.in, err, ErrInvalidAssetID)
+			}
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Errorf("ParseAssetID(%q) error = %#v, want *ValidationError", tt.in, err)
+			}
+		})
+	}
+}
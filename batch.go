@@ -0,0 +1,92 @@
+package cardanoasset
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// batchChunkSize is the number of assets a worker fingerprints between
+// cancellation checks.
+const batchChunkSize = 256
+
+// FingerprintBatch computes the fingerprints of assets in parallel. The result
+// is index-aligned with the input. It is FingerprintBatchContext with
+// context.Background().
+//
+// Example:
+//
+//	fps, err := cardanoasset.FingerprintBatch(assets)
+func FingerprintBatch(assets []Asset) ([]string, error) {
+	return FingerprintBatchContext(context.Background(), assets)
+}
+
+// FingerprintBatchContext computes the fingerprints of assets in parallel
+// using up to GOMAXPROCS workers, returning a slice index-aligned with the
+// input. Work is handed out in chunks and ctx is checked between chunks, so
+// cancellation returns promptly with ctx.Err(). The first asset that fails to
+// fingerprint stops the batch and its error is returned with the asset index.
+// All workers have exited by the time the function returns.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(r.Context(), time.Second)
+//	defer cancel()
+//	fps, err := cardanoasset.FingerprintBatchContext(ctx, assets)
+func FingerprintBatchContext(ctx context.Context, assets []Asset) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]string, len(assets))
+	chunks := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	numChunks := (len(assets) + batchChunkSize - 1) / batchChunkSize
+	workers := min(runtime.GOMAXPROCS(0), numChunks)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := min(start+batchChunkSize, len(assets))
+				for i := start; i < end; i++ {
+					fp, err := assets[i].Fingerprint()
+					if err != nil {
+						once.Do(func() {
+							firstErr = fmt.Errorf("asset %d: %w", i, err)
+							cancel()
+						})
+						break
+					}
+					results[i] = fp
+				}
+			}
+		}()
+	}
+
+feed:
+	for start := 0; start < len(assets); start += batchChunkSize {
+		select {
+		case chunks <- start:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(chunks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
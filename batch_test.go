@@ -0,0 +1,94 @@
+package cardanoasset
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// batchAssets returns n distinct valid assets.
+func batchAssets(n int) []Asset {
+	assets := make([]Asset, n)
+	for i := range assets {
+		assets[i] = Asset{PolicyID: testPolicy, AssetName: "SpaceBud" + strconv.Itoa(i)}
+	}
+	return assets
+}
+
+func TestFingerprintBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		assets  []Asset
+		wantErr error
+	}{
+		{name: "empty", assets: nil},
+		{name: "single chunk", assets: batchAssets(3)},
+		{name: "several chunks", assets: batchAssets(3*batchChunkSize + 1)},
+		{
+			name:    "invalid asset",
+			assets:  append(batchAssets(batchChunkSize), Asset{PolicyID: "abcd"}),
+			wantErr: ErrInvalidPolicyID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FingerprintBatch(tt.assets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintBatch error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(got) != len(tt.assets) {
+				t.Fatalf("len(FingerprintBatch) = %d, want %d", len(got), len(tt.assets))
+			}
+			for i, a := range tt.assets {
+				if want, _ := a.Fingerprint(); got[i] != want {
+					t.Errorf("FingerprintBatch[%d] = %s, want %s", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestFingerprintBatchContextCanceled(t *testing.T) {
+	assets := batchAssets(1 << 17)
+	before := runtime.NumGoroutine()
+
+	t.Run("already canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := FingerprintBatchContext(ctx, assets); !errors.Is(err, context.Canceled) {
+			t.Errorf("FingerprintBatchContext error = %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("canceled mid-flight", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		timer := time.AfterFunc(time.Millisecond, cancel)
+		defer timer.Stop()
+		fps, err := FingerprintBatchContext(ctx, assets)
+		if err == nil {
+			t.Skip("batch finished before cancellation fired")
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("FingerprintBatchContext error = %v, want %v", err, context.Canceled)
+		}
+		if fps != nil {
+			t.Errorf("FingerprintBatchContext returned %d results with an error", len(fps))
+		}
+	})
+
+	// Workers must all have exited; allow the runtime a moment to reap them.
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutines: %d before, %d after cancellation", before, after)
+	}
+}
@@ -1,6 +1,119 @@
 package cardanoasset
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Checksum constants XORed into the final polymod value. Plain bech32
+// (BIP-173) uses 1 and is what CIP-14 fingerprints use; bech32m (BIP-350)
+// uses 0x2bc830a3.
+const (
+	bech32Const  uint32 = 1
+	bech32mConst uint32 = 0x2bc830a3
+
+	// maxBech32Length is the BIP-173 cap on the total length of a bech32
+	// string. A CIP-14 fingerprint is always 44 characters, well inside it.
+	// Cardano addresses, which exceed it, cannot be produced by this encoder.
+	maxBech32Length = 90
+
+	// maxHRPLength is the BIP-173 cap on the human-readable part.
+	maxHRPLength = 83
+)
+
+// Error types for bech32 decoding.
+var (
+	ErrInvalidBech32    = errors.New("invalid bech32 string")
+	ErrBech32Checksum   = errors.New("invalid bech32 checksum")
+	ErrBech32TooLong    = errors.New("bech32 string too long: max 90 characters")
+	ErrBech32InvalidHRP = errors.New("invalid bech32 HRP: must be 1-83 lowercase printable ASCII characters")
+)
+
+// Bech32Encode encodes already 5-bit-grouped data into a bech32 string with
+// the given HRP, appending the BIP-173 checksum. Use ConvertBits to regroup
+// 8-bit bytes first. Bech32EncodeRaw is an alias with the same behavior.
+// Returns ErrBech32InvalidHRP if the HRP is empty, longer than 83 characters,
+// or contains characters outside printable ASCII, ErrBech32TooLong if the
+// result would exceed 90 characters, and ErrInvalidBech32 if a data byte does
+// not fit in 5 bits. data is only read. This is the building block for Cardano
+// bech32 strings other than fingerprints, such as stake1..., pool1... or
+// drep1... IDs.
+//
+// Example:
+//
+//	data, _ := cardanoasset.ConvertBits(poolHash, 8, 5, true)
+//	poolID, err := cardanoasset.Bech32Encode("pool", data)
+func Bech32Encode(hrp string, data []byte) (string, error) {
+	return encodeBech32(hrp, data, bech32Const)
+}
+
+// Bech32EncodeRaw is an alias of Bech32Encode whose name and parameter make
+// the input format explicit: data5bit is used as is, with no 8→5 regrouping.
+// It suits callers such as address encoders that already produce 5-bit
+// groups. The 90-character BIP-173 cap still applies, so full Cardano payment
+// addresses, which CIP-5 allows to exceed it, are rejected.
+// Returns the same errors as Bech32Encode.
+//
+// Example:
+//
+//	s, err := cardanoasset.Bech32EncodeRaw("a", []byte{0, 1, 2}) // "a1qpz3lspe2"
+func Bech32EncodeRaw(hrp string, data5bit []byte) (string, error) {
+	return encodeBech32(hrp, data5bit, bech32Const)
+}
+
+// Bech32Decode decodes a bech32 string into its lowercase HRP and 5-bit data
+// groups, with the checksum verified and removed. Use ConvertBits with
+// pad=false to recover 8-bit bytes.
+// Returns ErrInvalidBech32 for malformed input, ErrBech32TooLong for input
+// over 90 characters, and ErrBech32Checksum when the checksum does not match.
+//
+// Example:
+//
+//	hrp, data, err := cardanoasset.Bech32Decode("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3")
+//	hash, err := cardanoasset.ConvertBits(data, 5, 8, false)
+func Bech32Decode(s string) (hrp string, data []byte, err error) {
+	return decodeBech32(s, bech32Const)
+}
+
+// Bech32mEncode is Bech32Encode with the bech32m (BIP-350) checksum constant
+// 0x2bc830a3 in place of the BIP-173 constant 1. data must already be 5-bit
+// groups. CIP-14 fingerprints use plain bech32; use this only for formats
+// that specify bech32m.
+// Returns the same errors as Bech32Encode.
+//
+// Example:
+//
+//	s, err := cardanoasset.Bech32mEncode("a", nil) // "a1lqfn3a"
+func Bech32mEncode(hrp string, data []byte) (string, error) {
+	return encodeBech32(hrp, data, bech32mConst)
+}
+
+// Bech32mDecode is Bech32Decode with the bech32m (BIP-350) checksum constant.
+// A plain bech32 string fails its checksum here, and a bech32m string fails
+// in Bech32Decode, so the two variants cannot be confused.
+// Returns the same errors as Bech32Decode.
+//
+// Example:
+//
+//	hrp, data, err := cardanoasset.Bech32mDecode("a1lqfn3a") // "a", []
+func Bech32mDecode(s string) (hrp string, data []byte, err error) {
+	return decodeBech32(s, bech32mConst)
+}
+
+// ConvertBits regroups data from fromBits-wide to toBits-wide groups, as
+// bech32 requires for 8→5 (encode) and 5→8 (decode) conversion. With pad set,
+// trailing bits are zero-padded into a final group; without it, leftover bits
+// must be zero padding shorter than fromBits.
+// Returns an error wrapping ErrInvalidBech32 for group sizes outside 1-8, a
+// value wider than fromBits, or invalid padding.
+//
+// Example:
+//
+//	data5, err := cardanoasset.ConvertBits([]byte{0xff}, 8, 5, true) // [31 28]
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	return convertBits(data, fromBits, toBits, pad)
+}
 
 // bech32Encode encodes data bytes into a bech32 string with the given HRP.
 // This is a minimal, zero-dependency bech32 implementation sufficient for
@@ -10,7 +123,20 @@ func bech32Encode(hrp string, data []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return encodeBech32(hrp, conv)
+	return encodeBech32(hrp, conv, bech32Const)
+}
+
+// bech32Decode decodes a plain bech32 string into its HRP and data bytes.
+func bech32Decode(s string) (string, []byte, error) {
+	hrp, data, err := decodeBech32(s, bech32Const)
+	if err != nil {
+		return "", nil, err
+	}
+	conv, err := fromBase32(data)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, conv, nil
 }
 
 const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
@@ -47,10 +173,10 @@ func hrpExpand(hrp string) []byte {
 	return result
 }
 
-func createChecksum(hrp string, data []byte) []byte {
+func createChecksum(hrp string, data []byte, constant uint32) []byte {
 	values := append(hrpExpand(hrp), data...)
 	values = append(values, []byte{0, 0, 0, 0, 0, 0}...)
-	mod := polymod(values) ^ 1
+	mod := polymod(values) ^ constant
 	ret := make([]byte, 6)
 	for i := 0; i < 6; i++ {
 		ret[i] = byte((mod >> (5 * (5 - i))) & 31)
@@ -58,24 +184,103 @@ func createChecksum(hrp string, data []byte) []byte {
 	return ret
 }
 
-func encodeBech32(hrp string, data []byte) (string, error) {
-	combined := append(data, createChecksum(hrp, data)...)
-	result := hrp + "1"
-	for _, b := range combined {
+// validateHRP checks the BIP-173 HRP rules: 1-83 characters in the printable
+// ASCII range 33-126. Uppercase is also rejected, because the encoder emits a
+// lowercase data part and mixed-case strings are invalid.
+func validateHRP(hrp string) error {
+	if len(hrp) < 1 || len(hrp) > maxHRPLength {
+		return ErrBech32InvalidHRP
+	}
+	for i := 0; i < len(hrp); i++ {
+		c := hrp[i]
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return ErrBech32InvalidHRP
+		}
+	}
+	return nil
+}
+
+func verifyChecksum(hrp string, data []byte, constant uint32) bool {
+	return polymod(append(hrpExpand(hrp), data...)) == constant
+}
+
+func encodeBech32(hrp string, data []byte, constant uint32) (string, error) {
+	if err := validateHRP(hrp); err != nil {
+		return "", err
+	}
+	if len(hrp)+1+len(data)+6 > maxBech32Length {
+		return "", ErrBech32TooLong
+	}
+	for i, b := range data {
 		if int(b) >= len(charset) {
-			return "", fmt.Errorf("invalid bech32 data byte: %d", b)
+			return "", fmt.Errorf("%w: data byte %d at offset %d exceeds 5 bits", ErrInvalidBech32, b, i)
 		}
-		result += string(charset[b])
 	}
-	return result, nil
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + 6)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, b := range data {
+		sb.WriteByte(charset[b])
+	}
+	for _, b := range createChecksum(hrp, data, constant) {
+		sb.WriteByte(charset[b])
+	}
+	return sb.String(), nil
+}
+
+// fromBase32 converts 5-bit groups back into 8-bit bytes. It is the inverse of
+// the conversion performed by bech32Encode and rejects group sequences that do
+// not represent whole bytes (non-zero or over-long trailing padding).
+func fromBase32(data []byte) ([]byte, error) {
+	return convertBits(data, 5, 8, false)
+}
+
+// decodeBech32 splits s into its HRP and 5-bit data part (checksum removed),
+// verifying the checksum against constant. Mixed-case input is rejected; the
+// returned HRP is lowercase.
+func decodeBech32(s string, constant uint32) (string, []byte, error) {
+	if len(s) > maxBech32Length {
+		return "", nil, ErrBech32TooLong
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("%w: mixed case", ErrInvalidBech32)
+	}
+	s = strings.ToLower(s)
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, fmt.Errorf("%w: missing separator, HRP or checksum", ErrInvalidBech32)
+	}
+	hrp := s[:sep]
+	if err := validateHRP(hrp); err != nil {
+		return "", nil, err
+	}
+	data := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		v := strings.IndexByte(charset, s[i])
+		if v < 0 {
+			return "", nil, fmt.Errorf("%w: invalid data character %q", ErrInvalidBech32, s[i])
+		}
+		data = append(data, byte(v))
+	}
+	if !verifyChecksum(hrp, data, constant) {
+		return "", nil, ErrBech32Checksum
+	}
+	return hrp, data[:len(data)-6], nil
 }
 
 func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	if fromBits < 1 || fromBits > 8 || toBits < 1 || toBits > 8 {
+		return nil, fmt.Errorf("%w: bit group sizes %d→%d must be 1-8", ErrInvalidBech32, fromBits, toBits)
+	}
 	acc := 0
 	bits := uint(0)
 	var result []byte
 	maxv := (1 << toBits) - 1
 	for _, value := range data {
+		if int(value)>>fromBits != 0 {
+			return nil, fmt.Errorf("%w: data value %d exceeds %d bits", ErrInvalidBech32, value, fromBits)
+		}
 		acc = (acc << fromBits) | int(value)
 		bits += fromBits
 		for bits >= toBits {
@@ -88,7 +293,7 @@ func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
 			result = append(result, byte((acc<<(toBits-bits))&maxv))
 		}
 	} else if bits >= fromBits || ((acc<<(toBits-bits))&maxv) != 0 {
-		return nil, fmt.Errorf("invalid padding in bit conversion")
+		return nil, fmt.Errorf("%w: invalid padding in bit conversion", ErrInvalidBech32)
 	}
 	return result, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,347 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFromBase32(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    []byte
+		wantErr error
+	}{
+		{name: "empty", data: []byte{}, want: nil},
+		{name: "one byte", data: []byte{31, 28}, want: []byte{0xff}},
+		{name: "non-zero padding", data: []byte{31, 29}, wantErr: ErrInvalidBech32},
+		{name: "over-long padding", data: []byte{31, 28, 0}, wantErr: ErrInvalidBech32},
+		{name: "value wider than 5 bits", data: []byte{32, 0}, wantErr: ErrInvalidBech32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fromBase32(tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("fromBase32(%v) error = %v, want %v", tt.data, err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("fromBase32(%v) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromBase32RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "20-byte hash", data: bytes.Repeat([]byte{0xa5}, 20)},
+		{name: "21 bytes with padding bits", data: bytes.Repeat([]byte{0x5a}, 21)},
+		{name: "all zero", data: make([]byte, 7)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data5, err := convertBits(tt.data, 8, 5, true)
+			if err != nil {
+				t.Fatalf("convertBits: %v", err)
+			}
+			got, err := fromBase32(data5)
+			if err != nil {
+				t.Fatalf("fromBase32: %v", err)
+			}
+			if !bytes.Equal(got, tt.data) {
+				t.Errorf("round trip = %x, want %x", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestConvertBitsErrors(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             []byte
+		fromBits, toBits uint
+		pad              bool
+	}{
+		{name: "zero from bits", data: []byte{1}, fromBits: 0, toBits: 5, pad: true},
+		{name: "to bits above 8", data: []byte{1}, fromBits: 8, toBits: 9, pad: true},
+		{name: "value wider than from bits", data: []byte{0x20}, fromBits: 5, toBits: 8},
+		{name: "invalid padding", data: []byte{1}, fromBits: 5, toBits: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ConvertBits(tt.data, tt.fromBits, tt.toBits, tt.pad)
+			if !errors.Is(err, ErrInvalidBech32) {
+				t.Errorf("ConvertBits error = %v, want ErrInvalidBech32", err)
+			}
+		})
+	}
+}
+
+// bip350Valid are the valid bech32m test vectors from BIP-350.
+var bip350Valid = []string{
+	"A1LQFN3A",
+	"a1lqfn3a",
+	"an83characterlonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11sg7hg6",
+	"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx",
+	"11llllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllludsr8",
+	"split1checkupstagehandshakeupstreamerranterredcaperredlc445v",
+	"?1v759aa",
+}
+
+// bip173Valid are the valid bech32 test vectors from BIP-173.
+var bip173Valid = []string{
+	"A12UEL5L",
+	"a12uel5l",
+	"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+	"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+	"11qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqc8247j",
+	"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	"?1ezyfcl",
+}
+
+func TestBech32mVectors(t *testing.T) {
+	for _, s := range bip350Valid {
+		t.Run(s, func(t *testing.T) {
+			hrp, data, err := Bech32mDecode(s)
+			if err != nil {
+				t.Fatalf("Bech32mDecode(%q) error = %v", s, err)
+			}
+			got, err := Bech32mEncode(hrp, data)
+			if err != nil {
+				t.Fatalf("Bech32mEncode(%q) error = %v", hrp, err)
+			}
+			if want := strings.ToLower(s); got != want {
+				t.Errorf("Bech32mEncode(Bech32mDecode(%q)) = %q, want %q", s, got, want)
+			}
+			if _, _, err := Bech32Decode(s); !errors.Is(err, ErrBech32Checksum) {
+				t.Errorf("Bech32Decode(%q) error = %v, want %v", s, err, ErrBech32Checksum)
+			}
+		})
+	}
+}
+
+func TestBech32Vectors(t *testing.T) {
+	for _, s := range bip173Valid {
+		t.Run(s, func(t *testing.T) {
+			hrp, data, err := Bech32Decode(s)
+			if err != nil {
+				t.Fatalf("Bech32Decode(%q) error = %v", s, err)
+			}
+			got, err := Bech32Encode(hrp, data)
+			if err != nil {
+				t.Fatalf("Bech32Encode(%q) error = %v", hrp, err)
+			}
+			if want := strings.ToLower(s); got != want {
+				t.Errorf("Bech32Encode(Bech32Decode(%q)) = %q, want %q", s, got, want)
+			}
+			if _, _, err := Bech32mDecode(s); !errors.Is(err, ErrBech32Checksum) {
+				t.Errorf("Bech32mDecode(%q) error = %v, want %v", s, err, ErrBech32Checksum)
+			}
+		})
+	}
+}
+
+func TestBech32mDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{name: "no separator", in: "qyrz8wqd2c9m", wantErr: ErrInvalidBech32},
+		{name: "empty hrp", in: "1qyrz8wqd2c9m", wantErr: ErrInvalidBech32},
+		{name: "invalid data character", in: "y1b0jsk6g", wantErr: ErrInvalidBech32},
+		{name: "checksum too short", in: "lt1igcx5c0", wantErr: ErrInvalidBech32},
+		{name: "mixed case", in: "a1LQFN3A", wantErr: ErrInvalidBech32},
+		{name: "checksum from uppercase hrp", in: "A1G7SGD8", wantErr: ErrBech32Checksum},
+		{name: "bech32 checksum", in: "M1VUXWEZ", wantErr: ErrBech32Checksum},
+		{name: "hrp character out of range", in: "\x7f1g6xzxy", wantErr: ErrBech32InvalidHRP},
+		{name: "overall max length exceeded", in: "an84characterslonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11d6pts4", wantErr: ErrBech32TooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Bech32mDecode(tt.in); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Bech32mDecode(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBech32EncodeDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		hrp     string
+		data    []byte
+		want    string
+		wantErr error
+	}{
+		{name: "empty data", hrp: "a", want: "a12uel5l"},
+		{name: "pool hrp", hrp: "pool", data: []byte{0, 1, 2, 31}},
+		{name: "83-char hrp", hrp: strings.Repeat("a", 83)},
+		{name: "84-char hrp", hrp: strings.Repeat("a", 84), wantErr: ErrBech32InvalidHRP},
+		{name: "empty hrp", hrp: "", wantErr: ErrBech32InvalidHRP},
+		{name: "over 90 chars", hrp: "a", data: make([]byte, 83), wantErr: ErrBech32TooLong},
+		{name: "data byte over 5 bits", hrp: "a", data: []byte{0, 32}, wantErr: ErrInvalidBech32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bech32Encode(tt.hrp, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Bech32Encode(%q, %v) error = %v, want %v", tt.hrp, tt.data, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("Bech32Encode(%q, %v) = %q, want %q", tt.hrp, tt.data, got, tt.want)
+			}
+			hrp, data, err := Bech32Decode(got)
+			if err != nil {
+				t.Fatalf("Bech32Decode(%q) error = %v", got, err)
+			}
+			if hrp != tt.hrp || !bytes.Equal(data, tt.data) {
+				t.Errorf("Bech32Decode(%q) = (%q, %v), want (%q, %v)", got, hrp, data, tt.hrp, tt.data)
+			}
+		})
+	}
+}
+
+func TestBech32EncodeDoesNotWriteSpareCapacity(t *testing.T) {
+	buf := make([]byte, 4, 16)
+	for i := range buf[:cap(buf)] {
+		buf[:cap(buf)][i] = 0xee
+	}
+	data := buf[:4]
+	for i := range data {
+		data[i] = byte(i)
+	}
+	if _, err := Bech32Encode("a", data); err != nil {
+		t.Fatalf("Bech32Encode error = %v", err)
+	}
+	for i, b := range buf[len(data):cap(buf)] {
+		if b != 0xee {
+			t.Fatalf("spare capacity byte %d = %#x, want 0xee", i, b)
+		}
+	}
+}
+
+func TestBech32LengthLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		dataLen int
+		wantErr error
+	}{
+		{name: "89 chars", dataLen: 81},
+		{name: "90 chars", dataLen: 82},
+		{name: "91 chars", dataLen: 83, wantErr: ErrBech32TooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Bech32Encode("a", make([]byte, tt.dataLen))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Bech32Encode error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if want := 1 + 1 + tt.dataLen + 6; len(s) != want {
+					t.Fatalf("len(Bech32Encode()) = %d, want %d", len(s), want)
+				}
+				if _, _, err := Bech32Decode(s); err != nil {
+					t.Errorf("Bech32Decode(%q) error = %v", s, err)
+				}
+				return
+			}
+			long := "a1" + strings.Repeat("q", tt.dataLen+6)
+			if _, _, err := Bech32Decode(long); !errors.Is(err, ErrBech32TooLong) {
+				t.Errorf("Bech32Decode(%d chars) error = %v, want %v", len(long), err, ErrBech32TooLong)
+			}
+		})
+	}
+}
+
+func TestFingerprintWithinBech32Limit(t *testing.T) {
+	for _, v := range cip14Vectors {
+		t.Run(v.fingerprint, func(t *testing.T) {
+			if len(v.fingerprint) > maxBech32Length {
+				t.Errorf("len(%q) = %d exceeds %d", v.fingerprint, len(v.fingerprint), maxBech32Length)
+			}
+		})
+	}
+}
+
+func TestBech32EncodeHRPValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		hrp     string
+		wantErr error
+	}{
+		{name: "asset", hrp: "asset"},
+		{name: "printable punctuation", hrp: "!~"},
+		{name: "empty", hrp: "", wantErr: ErrBech32InvalidHRP},
+		{name: "space", hrp: "as set", wantErr: ErrBech32InvalidHRP},
+		{name: "control character", hrp: "a\x7f", wantErr: ErrBech32InvalidHRP},
+		{name: "non-ascii", hrp: "é", wantErr: ErrBech32InvalidHRP},
+		{name: "uppercase", hrp: "ASSET", wantErr: ErrBech32InvalidHRP},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Bech32Encode(tt.hrp, []byte{1, 2, 3}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Bech32Encode(%q) error = %v, want %v", tt.hrp, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBech32EncodeRawIsAlias(t *testing.T) {
+	data := []byte{0, 1, 2}
+	want, err := Bech32Encode("a", data)
+	if err != nil {
+		t.Fatalf("Bech32Encode error = %v", err)
+	}
+	got, err := Bech32EncodeRaw("a", data)
+	if err != nil || got != want {
+		t.Errorf("Bech32EncodeRaw = (%q, %v), want (%q, nil)", got, err, want)
+	}
+}
+
+func TestBech32EncodeRaw(t *testing.T) {
+	all := make([]byte, 32)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	tests := []struct {
+		name     string
+		hrp      string
+		data5bit []byte
+		want     string
+		wantErr  error
+	}{
+		{name: "doc example", hrp: "a", data5bit: []byte{0, 1, 2}, want: "a1qpz3lspe2"},
+		{name: "every 5-bit value", hrp: "stake", data5bit: all, want: "stake1qpzry9x8gf2tvdw0s3jn54khce6mua7l8y8qax"},
+		{name: "byte of 32 rejected", hrp: "a", data5bit: []byte{0, 32}, wantErr: ErrInvalidBech32},
+		{name: "8-bit byte rejected", hrp: "a", data5bit: []byte{0xff}, wantErr: ErrInvalidBech32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bech32EncodeRaw(tt.hrp, tt.data5bit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Bech32EncodeRaw() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Bech32EncodeRaw() = %q, want %q", got, tt.want)
+			}
+			hrp, data, err := Bech32Decode(got)
+			if err != nil {
+				t.Fatalf("Bech32Decode(%q) error = %v", got, err)
+			}
+			if hrp != tt.hrp || !bytes.Equal(data, tt.data5bit) {
+				t.Errorf("Bech32Decode(%q) = (%q, %v), want (%q, %v)", got, hrp, data, tt.hrp, tt.data5bit)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package cardanoasset
+
+import "math/big"
+
+// BigValue is an arbitrary-precision counterpart of Value with the same
+// Get/Add/Sub API, mapping each asset to a non-negative *big.Int quantity.
+//
+// Use Value for anything that mirrors the ledger: every on-chain output
+// quantity fits in 64 bits. Use BigValue for analytics that aggregate many
+// outputs, where totals such as the summed supply of a large fungible token
+// can exceed uint64. A BigValue is a map, so modifying methods require a
+// non-nil BigValue and are not safe for concurrent use.
+type BigValue map[Asset]*big.Int
+
+// Get returns a copy of the quantity of asset a held in v, or 0 if absent.
+//
+// Example:
+//
+//	supply := total.Get(hosky)
+func (v BigValue) Get(a Asset) *big.Int {
+	if n, ok := v[a]; ok {
+		return new(big.Int).Set(n)
+	}
+	return new(big.Int)
+}
+
+// Add increases the quantity of asset a by amount. amount is copied, never
+// retained. Returns ErrAmountUnderflow, leaving v unchanged, if amount is
+// negative enough to make the quantity negative.
+//
+// Example:
+//
+//	total := cardanoasset.BigValue{}
+//	err := total.Add(hosky, new(big.Int).SetUint64(math.MaxUint64))
+func (v BigValue) Add(a Asset, amount *big.Int) error {
+	sum := new(big.Int).Add(v.Get(a), amount)
+	if sum.Sign() < 0 {
+		return ErrAmountUnderflow
+	}
+	v.set(a, sum)
+	return nil
+}
+
+// Sub decreases the quantity of asset a by amount, removing the entry when it
+// reaches zero. Returns ErrAmountUnderflow, leaving v unchanged, if v holds
+// less than amount.
+//
+// Example:
+//
+//	err := total.Sub(hosky, burned)
+func (v BigValue) Sub(a Asset, amount *big.Int) error {
+	diff := new(big.Int).Sub(v.Get(a), amount)
+	if diff.Sign() < 0 {
+		return ErrAmountUnderflow
+	}
+	v.set(a, diff)
+	return nil
+}
+
+// AddValue adds every quantity in other to v. It cannot overflow.
+//
+// Example:
+//
+//	total := cardanoasset.BigValue{}
+//	for _, utxo := range utxos {
+//	    total.AddValue(utxo.Value)
+//	}
+func (v BigValue) AddValue(other Value) {
+	for a, amount := range other {
+		v.set(a, new(big.Int).Add(v.Get(a), new(big.Int).SetUint64(amount)))
+	}
+}
+
+// Value converts v back to a ledger-sized Value.
+// Returns ErrAmountOverflow if any quantity exceeds uint64.
+//
+// Example:
+//
+//	v, err := total.Value()
+func (v BigValue) Value() (Value, error) {
+	out := make(Value, len(v))
+	for a, n := range v {
+		if !n.IsUint64() {
+			return nil, ErrAmountOverflow
+		}
+		out[a] = n.Uint64()
+	}
+	return out, nil
+}
+
+// set stores n under a, deleting the entry when n is zero.
+func (v BigValue) set(a Asset, n *big.Int) {
+	if n.Sign() == 0 {
+		delete(v, a)
+		return
+	}
+	v[a] = n
+}
@@ -0,0 +1,96 @@
+package cardanoasset
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigValueSumBeyondUint64(t *testing.T) {
+	hosky := Asset{PolicyID: testPolicy, AssetName: "HOSKY"}
+	utxos := []Value{
+		{Lovelace: 2000000, hosky: math.MaxUint64},
+		{hosky: math.MaxUint64},
+		{hosky: 2},
+	}
+	total := BigValue{}
+	for _, u := range utxos {
+		total.AddValue(u)
+	}
+	tests := []struct {
+		name  string
+		asset Asset
+		want  string
+	}{
+		{name: "overflowing token", asset: hosky, want: "36893488147419103232"},
+		{name: "lovelace", asset: Lovelace, want: "2000000"},
+		{name: "absent", asset: Asset{PolicyID: testPolicy, AssetName: "X"}, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := total.Get(tt.asset); got.String() != tt.want {
+				t.Errorf("Get(%v) = %s, want %s", tt.asset, got, tt.want)
+			}
+		})
+	}
+	t.Run("Value overflows", func(t *testing.T) {
+		if _, err := total.Value(); !errors.Is(err, ErrAmountOverflow) {
+			t.Errorf("Value() error = %v, want %v", err, ErrAmountOverflow)
+		}
+	})
+	t.Run("uint64 Merge overflows", func(t *testing.T) {
+		if _, err := utxos[0].Merge(utxos[1]); !errors.Is(err, ErrAmountOverflow) {
+			t.Errorf("Merge() error = %v, want %v", err, ErrAmountOverflow)
+		}
+	})
+}
+
+func TestBigValueAddSub(t *testing.T) {
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	tests := []struct {
+		name    string
+		start   int64
+		op      func(BigValue) error
+		want    string
+		wantErr error
+	}{
+		{name: "add", start: 5, op: func(v BigValue) error { return v.Add(token, big.NewInt(3)) }, want: "8"},
+		{name: "add negative", start: 5, op: func(v BigValue) error { return v.Add(token, big.NewInt(-5)) }, want: "0"},
+		{name: "add below zero", start: 5, op: func(v BigValue) error { return v.Add(token, big.NewInt(-6)) }, want: "5", wantErr: ErrAmountUnderflow},
+		{name: "sub", start: 5, op: func(v BigValue) error { return v.Sub(token, big.NewInt(2)) }, want: "3"},
+		{name: "sub to zero", start: 5, op: func(v BigValue) error { return v.Sub(token, big.NewInt(5)) }, want: "0"},
+		{name: "sub underflow", start: 5, op: func(v BigValue) error { return v.Sub(token, big.NewInt(6)) }, want: "5", wantErr: ErrAmountUnderflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := BigValue{token: big.NewInt(tt.start)}
+			if err := tt.op(v); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got := v.Get(token); got.String() != tt.want {
+				t.Errorf("Get() = %s, want %s", got, tt.want)
+			}
+			if tt.want == "0" {
+				if _, ok := v[token]; ok {
+					t.Errorf("zero quantity left an entry in the map")
+				}
+			}
+		})
+	}
+}
+
+func TestBigValueDoesNotRetain(t *testing.T) {
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	amount := big.NewInt(7)
+	v := BigValue{}
+	if err := v.Add(token, amount); err != nil {
+		t.Fatal(err)
+	}
+	amount.SetInt64(100)
+	got := v.Get(token)
+	got.SetInt64(200)
+	if n := v.Get(token); n.Int64() != 7 {
+		t.Errorf("Get() = %s after mutating the argument and result, want 7", n)
+	}
+}
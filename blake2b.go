@@ -0,0 +1,123 @@
+package cardanoasset
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Digest sizes used by Cardano. Blake2b-160 hashes CIP-14 fingerprints,
+// Blake2b-224 hashes scripts and keys (policy IDs are script hashes), and
+// Blake2b-256 hashes transactions and other content.
+const (
+	blake2b160Size = 20
+	blake2b224Size = 28
+	blake2b256Size = 32
+)
+
+// blake2bBlockSize is the Blake2b compression block size in bytes.
+const blake2bBlockSize = 128
+
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var blake2bSigma = [12][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+// blake2bSum computes the unkeyed Blake2b digest (RFC 7693) of data with the
+// given output size in bytes, which must be between 1 and 64. The package
+// uses size 20 (blake2b-160) for CIP-14 fingerprints, size 28 (blake2b-224)
+// for script hashes and size 32 (blake2b-256) for value hashes.
+func blake2bSum(data []byte, size int) []byte {
+	if size < 1 || size > 64 {
+		panic("cardanoasset: invalid blake2b digest size")
+	}
+	h := blake2bIV
+	h[0] ^= 0x01010000 ^ uint64(size)
+
+	var counter uint64
+	for len(data) > blake2bBlockSize {
+		counter += blake2bBlockSize
+		blake2bCompress(&h, data[:blake2bBlockSize], counter, false)
+		data = data[blake2bBlockSize:]
+	}
+	var last [blake2bBlockSize]byte
+	copy(last[:], data)
+	counter += uint64(len(data))
+	blake2bCompress(&h, last[:], counter, true)
+
+	var out [64]byte
+	for i, v := range h {
+		binary.LittleEndian.PutUint64(out[i*8:], v)
+	}
+	return append([]byte(nil), out[:size]...)
+}
+
+// blake2bCompress is the Blake2b compression function F. Inputs are limited
+// to far less than 2^64 bytes, so the high word of the counter is always 0.
+func blake2bCompress(h *[8]uint64, block []byte, counter uint64, final bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(block[i*8:])
+	}
+	var v [16]uint64
+	copy(v[:8], h[:])
+	copy(v[8:], blake2bIV[:])
+	v[12] ^= counter
+	if final {
+		v[14] = ^v[14]
+	}
+	for _, s := range blake2bSigma {
+		blake2bMix(&v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+		blake2bMix(&v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+		blake2bMix(&v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+		blake2bMix(&v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+		blake2bMix(&v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+		blake2bMix(&v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+		blake2bMix(&v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+		blake2bMix(&v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// blake2bMix is the Blake2b mixing function G.
+func blake2bMix(v *[16]uint64, a, b, c, d int, x, y uint64) {
+	v[a] += v[b] + x
+	v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+	v[a] += v[b] + y
+	v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+}
+
+// blake2b160 computes the 20-byte Blake2b-160 digest used by CIP-14.
+func blake2b160(data []byte) []byte {
+	return blake2bSum(data, blake2b160Size)
+}
+
+// blake2b224 computes the 28-byte Blake2b-224 digest used for script hashes.
+func blake2b224(data []byte) []byte {
+	return blake2bSum(data, blake2b224Size)
+}
+
+// blake2b256 computes the 32-byte Blake2b-256 digest.
+func blake2b256(data []byte) []byte {
+	return blake2bSum(data, blake2b256Size)
+}
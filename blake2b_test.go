@@ -0,0 +1,66 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Reference digests computed with Python's hashlib.blake2b(digest_size=n);
+// the 64-byte "abc" digest is the RFC 7693 Appendix A vector.
+func TestBlake2bSum(t *testing.T) {
+	seq := make([]byte, 256)
+	for i := range seq {
+		seq[i] = byte(i)
+	}
+	tests := []struct {
+		name string
+		data []byte
+		size int
+		want string
+	}{
+		{name: "empty/160", data: nil, size: blake2b160Size, want: "3345524abf6bbe1809449224b5972c41790b6cf2"},
+		{name: "empty/224", data: nil, size: blake2b224Size, want: "836cc68931c2e4e3e838602eca1902591d216837bafddfe6f0c8cb07"},
+		{name: "abc/160", data: []byte("abc"), size: blake2b160Size, want: "384264f676f39536840523f284921cdc68b6846b"},
+		{name: "abc/224", data: []byte("abc"), size: blake2b224Size, want: "9bd237b02a29e43bdd6738afa5b53ff0eee178d6210b618e4511aec8"},
+		{name: "abc/256", data: []byte("abc"), size: blake2b256Size, want: "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{name: "abc/512 rfc 7693", data: []byte("abc"), size: 64, want: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+		{name: "one full block/160", data: bytes.Repeat([]byte("a"), 128), size: blake2b160Size, want: "353a80c4a604c3e7897991a345a45133f80c4a55"},
+		{name: "one full block/224", data: bytes.Repeat([]byte("a"), 128), size: blake2b224Size, want: "d5df9e9a3d386e984c5464df2c67c4c2b2e74ff4f60fa19f3f37d479"},
+		{name: "block plus one/160", data: bytes.Repeat([]byte("a"), 129), size: blake2b160Size, want: "eeff408d65ecf3235b2586586d331fea9014b8d8"},
+		{name: "block plus one/224", data: bytes.Repeat([]byte("a"), 129), size: blake2b224Size, want: "39d2c78556ad2b0fdcfdfdce9c017310a84e5609cfe3ace0804827b7"},
+		{name: "two blocks/160", data: seq, size: blake2b160Size, want: "2433af65183f411941345962733a8860df650139"},
+		{name: "two blocks/224", data: seq, size: blake2b224Size, want: "36b87bde0ee2893d8f0e7fe59f60c6cb4a2d8aebdc8b1966380bfd57"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blake2bSum(tt.data, tt.size)
+			if len(got) != tt.size {
+				t.Fatalf("len(blake2bSum()) = %d, want %d", len(got), tt.size)
+			}
+			if h := hex.EncodeToString(got); h != tt.want {
+				t.Errorf("blake2bSum(size %d) = %s, want %s", tt.size, h, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlake2bSizedHelpers(t *testing.T) {
+	data := []byte("abc")
+	tests := []struct {
+		name string
+		fn   func([]byte) []byte
+		size int
+	}{
+		{name: "blake2b160", fn: blake2b160, size: blake2b160Size},
+		{name: "blake2b224", fn: blake2b224, size: blake2b224Size},
+		{name: "blake2b256", fn: blake2b256, size: blake2b256Size},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := tt.fn(data), blake2bSum(data, tt.size); !bytes.Equal(got, want) {
+				t.Errorf("%s() = %x, want %x", tt.name, got, want)
+			}
+		})
+	}
+}
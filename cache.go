@@ -0,0 +1,82 @@
+package cardanoasset
+
+import (
+	"container/list"
+	"sync"
+)
+
+// FingerprintCache memoizes asset fingerprints. Fingerprints are
+// deterministic, so a cached value never goes stale. When created with a
+// positive capacity the cache evicts the least recently used entry once
+// full. A FingerprintCache is safe for concurrent use; the zero value is not
+// usable, create one with NewFingerprintCache.
+type FingerprintCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // front = most recently used; values are *fingerprintCacheEntry
+	entries    map[Asset]*list.Element
+}
+
+type fingerprintCacheEntry struct {
+	asset       Asset
+	fingerprint string
+}
+
+// NewFingerprintCache returns a cache holding at most maxEntries
+// fingerprints, evicting the least recently used beyond that. A maxEntries of
+// zero or less means unbounded.
+//
+// Example:
+//
+//	cache := cardanoasset.NewFingerprintCache(10000)
+func NewFingerprintCache(maxEntries int) *FingerprintCache {
+	return &FingerprintCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[Asset]*list.Element),
+	}
+}
+
+// Get returns the fingerprint of a, computing and caching it on a miss.
+// Errors are returned as from Asset.Fingerprint and are not cached.
+//
+// Example:
+//
+//	fp, err := cache.Get(a)
+func (c *FingerprintCache) Get(a Asset) (string, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[a]; ok {
+		c.order.MoveToFront(el)
+		fp := el.Value.(*fingerprintCacheEntry).fingerprint
+		c.mu.Unlock()
+		return fp, nil
+	}
+	c.mu.Unlock()
+
+	// Hash outside the lock so misses on different assets run in parallel.
+	fp, err := a.Fingerprint()
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[a]; ok {
+		c.order.MoveToFront(el)
+		return fp, nil
+	}
+	c.entries[a] = c.order.PushFront(&fingerprintCacheEntry{asset: a, fingerprint: fp})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*fingerprintCacheEntry).asset)
+	}
+	return fp, nil
+}
+
+// Len returns the number of cached fingerprints.
+func (c *FingerprintCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
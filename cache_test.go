@@ -0,0 +1,114 @@
+package cardanoasset
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestFingerprintCache(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxEntries int
+		gets       []int // indexes into batchAssets
+		wantLen    int
+	}{
+		{name: "unbounded", maxEntries: 0, gets: []int{0, 1, 2, 0, 1}, wantLen: 3},
+		{name: "capped", maxEntries: 2, gets: []int{0, 1, 2, 3}, wantLen: 2},
+		{name: "repeated hits", maxEntries: 2, gets: []int{0, 0, 0}, wantLen: 1},
+	}
+	assets := batchAssets(4)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewFingerprintCache(tt.maxEntries)
+			for _, i := range tt.gets {
+				got, err := c.Get(assets[i])
+				if err != nil {
+					t.Fatalf("Get(%v) error = %v", assets[i], err)
+				}
+				if want, _ := assets[i].Fingerprint(); got != want {
+					t.Errorf("Get(%v) = %s, want %s", assets[i], got, want)
+				}
+			}
+			if got := c.Len(); got != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestFingerprintCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	assets := batchAssets(3)
+	c := NewFingerprintCache(2)
+	for _, a := range []Asset{assets[0], assets[1], assets[0], assets[2]} {
+		if _, err := c.Get(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.mu.Lock()
+	_, has0 := c.entries[assets[0]]
+	_, has1 := c.entries[assets[1]]
+	c.mu.Unlock()
+	if !has0 || has1 {
+		t.Errorf("after touching asset 0, cached = {0: %v, 1: %v}, want {0: true, 1: false}", has0, has1)
+	}
+}
+
+func TestFingerprintCacheErrorNotCached(t *testing.T) {
+	c := NewFingerprintCache(0)
+	if _, err := c.Get(Asset{PolicyID: "abcd"}); !errors.Is(err, ErrInvalidPolicyID) {
+		t.Fatalf("Get error = %v, want %v", err, ErrInvalidPolicyID)
+	}
+	if got := c.Len(); got != 0 {
+		t.Errorf("Len() = %d after an error, want 0", got)
+	}
+}
+
+func TestFingerprintCacheConcurrent(t *testing.T) {
+	assets := batchAssets(64)
+	want := make([]string, len(assets))
+	for i, a := range assets {
+		want[i], _ = a.Fingerprint()
+	}
+	c := NewFingerprintCache(32)
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				i := (g*7 + j) % len(assets)
+				got, err := c.Get(assets[i])
+				if err != nil || got != want[i] {
+					t.Errorf("Get(%d) = (%s, %v), want %s", i, got, err, want[i])
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if got := c.Len(); got > 32 {
+		t.Errorf("Len() = %d, exceeds cap 32", got)
+	}
+}
+
+func BenchmarkFingerprintCache(b *testing.B) {
+	assets := batchAssets(1024)
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := assets[i%len(assets)].Fingerprint(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		c := NewFingerprintCache(len(assets))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.Get(assets[i%len(assets)]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
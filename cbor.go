@@ -0,0 +1,370 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidCBOR is returned when CBOR input is malformed or does not have
+// the expected ledger structure.
+var ErrInvalidCBOR = errors.New("invalid CBOR")
+
+// CBOR major types used by the ledger value encoding (RFC 8949).
+const (
+	cborUnsigned byte = 0
+	cborNegative byte = 1
+	cborBytes    byte = 2
+	cborArray    byte = 4
+	cborMap      byte = 5
+)
+
+// appendCBORHead appends a CBOR item head for major type major and argument
+// n, using the shortest encoding as canonical CBOR requires.
+func appendCBORHead(buf []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(buf, m|byte(n))
+	case n <= math.MaxUint8:
+		return append(buf, m|24, byte(n))
+	case n <= math.MaxUint16:
+		return append(buf, m|25, byte(n>>8), byte(n))
+	case n <= math.MaxUint32:
+		return append(buf, m|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	default:
+		return append(buf, m|27,
+			byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32),
+			byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+// cborHeadSize returns the encoded length of a CBOR item head with argument n.
+func cborHeadSize(n uint64) int {
+	switch {
+	case n < 24:
+		return 1
+	case n <= math.MaxUint8:
+		return 2
+	case n <= math.MaxUint16:
+		return 3
+	case n <= math.MaxUint32:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// appendCBORInt appends a signed integer as CBOR major type 0 or 1.
+func appendCBORInt(buf []byte, n int64) []byte {
+	if n < 0 {
+		return appendCBORHead(buf, cborNegative, uint64(-(n + 1)))
+	}
+	return appendCBORHead(buf, cborUnsigned, uint64(n))
+}
+
+// appendCBORBytes appends b as a CBOR byte string.
+func appendCBORBytes(buf, b []byte) []byte {
+	buf = appendCBORHead(buf, cborBytes, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// appendMultiAsset appends the ledger multi-asset map
+// {policy_id => {asset_name => quantity}} for assets, which must be sorted in
+// canonical order and exclude Lovelace. appendAmount encodes each quantity.
+func appendMultiAsset(buf []byte, assets []Asset, appendAmount func([]byte, Asset) []byte) ([]byte, error) {
+	policies := 0
+	for i, a := range assets {
+		if i == 0 || a.PolicyID != assets[i-1].PolicyID {
+			policies++
+		}
+	}
+	buf = appendCBORHead(buf, cborMap, uint64(policies))
+	for i := 0; i < len(assets); {
+		j := i
+		for j < len(assets) && assets[j].PolicyID == assets[i].PolicyID {
+			j++
+		}
+		policyBytes, err := hex.DecodeString(assets[i].PolicyID)
+		if err != nil {
+			return nil, ErrInvalidPolicyID
+		}
+		buf = appendCBORBytes(buf, policyBytes)
+		buf = appendCBORHead(buf, cborMap, uint64(j-i))
+		for _, a := range assets[i:j] {
+			buf = appendCBORBytes(buf, []byte(a.AssetName))
+			buf = appendAmount(buf, a)
+		}
+		i = j
+	}
+	return buf, nil
+}
+
+// MarshalMintCBOR encodes v as the transaction body mint field: the
+// multi-asset map {policy_id => {asset_name => int64}} with canonical key
+// ordering. Lovelace and zero-amount entries are skipped because ADA cannot
+// be minted. Value quantities are unsigned, so the result only describes
+// mints; use MintValue for burns.
+// Returns ErrAmountOverflow if a quantity exceeds the int64 range of the mint
+// field, or the asset's validation error if it is malformed.
+//
+// Example:
+//
+//	mint := cardanoasset.Value{a: 1}
+//	field, err := mint.MarshalMintCBOR()
+func (v Value) MarshalMintCBOR() ([]byte, error) {
+	assets := make([]Asset, 0, len(v))
+	for _, a := range v.Assets() {
+		if a == Lovelace || v[a] == 0 {
+			continue
+		}
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		if v[a] > math.MaxInt64 {
+			return nil, ErrAmountOverflow
+		}
+		assets = append(assets, a)
+	}
+	return appendMultiAsset(nil, assets, func(buf []byte, a Asset) []byte {
+		return appendCBORInt(buf, int64(v[a]))
+	})
+}
+
+// MarshalCBOR encodes v as the ledger value: a bare coin when v holds only
+// Lovelace, otherwise the array [coin, {policy_id => {asset_name => uint}}]
+// with canonical key ordering. Zero-amount entries are skipped.
+// Returns the asset's validation error if a key is malformed.
+//
+// Example:
+//
+//	v := cardanoasset.Value{cardanoasset.Lovelace: 2000000, a: 1}
+//	data, err := v.MarshalCBOR()
+func (v Value) MarshalCBOR() ([]byte, error) {
+	assets := make([]Asset, 0, len(v))
+	for _, a := range v.Assets() {
+		if a == Lovelace || v[a] == 0 {
+			continue
+		}
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		assets = append(assets, a)
+	}
+	coin := v[Lovelace]
+	if len(assets) == 0 {
+		return appendCBORHead(nil, cborUnsigned, coin), nil
+	}
+	buf := appendCBORHead(nil, cborArray, 2)
+	buf = appendCBORHead(buf, cborUnsigned, coin)
+	return appendMultiAsset(buf, assets, func(buf []byte, a Asset) []byte {
+		return appendCBORHead(buf, cborUnsigned, v[a])
+	})
+}
+
+// Hash returns the Blake2b-256 digest of v's canonical CBOR encoding (see
+// MarshalCBOR), a stable content identifier for deduplicating or detecting
+// changes in balance snapshots. Canonical key ordering makes it independent
+// of insertion order, and zero-amount entries, which MarshalCBOR skips, do
+// not affect it. Returns nil if v holds an invalid asset.
+//
+// Example:
+//
+//	if !bytes.Equal(snapshot.Hash(), previous.Hash()) {
+//	    // balance changed
+//	}
+func (v Value) Hash() []byte {
+	data, err := v.MarshalCBOR()
+	if err != nil {
+		return nil
+	}
+	return blake2b256(data)
+}
+
+// SerializedSize returns len(v.MarshalCBOR()) without building the encoding.
+// A lovelace-only value is a bare coin of size head(coin). Otherwise the size
+// is 1 (array head) + head(coin) + head(P) plus, for each of the P policies,
+// 2 + 28 (policy ID byte string) + head(N) and, for each of its N assets,
+// head(len(name)) + len(name) + head(quantity), where head(n) is the 1, 2, 3,
+// 5 or 9 byte CBOR head for argument n. Zero-amount entries are skipped, as
+// in MarshalCBOR. Assets are not validated.
+//
+// Example:
+//
+//	size := v.SerializedSize()
+func (v Value) SerializedSize() int {
+	assetsPerPolicy := make(map[string]uint64)
+	body := 0
+	for a, amount := range v {
+		if a == Lovelace || amount == 0 {
+			continue
+		}
+		assetsPerPolicy[a.PolicyID]++
+		body += cborHeadSize(uint64(len(a.AssetName))) + len(a.AssetName) + cborHeadSize(amount)
+	}
+	coin := cborHeadSize(v[Lovelace])
+	if len(assetsPerPolicy) == 0 {
+		return coin
+	}
+	size := 1 + coin + cborHeadSize(uint64(len(assetsPerPolicy))) + body
+	for policyID, n := range assetsPerPolicy {
+		policyLen := uint64(len(policyID) / 2)
+		size += cborHeadSize(policyLen) + int(policyLen) + cborHeadSize(n)
+	}
+	return size
+}
+
+// ParseValueCBOR decodes a ledger value as produced by Value.MarshalCBOR:
+// either a bare coin or [coin, {policy_id => {asset_name => uint}}].
+// Only definite-length items are supported. Zero quantities are dropped.
+// Returns an error wrapping ErrInvalidCBOR for malformed input, trailing
+// bytes or duplicate keys, or the NewAsset error for an invalid asset.
+//
+// Example:
+//
+//	v, err := cardanoasset.ParseValueCBOR([]byte{0x1a, 0x00, 0x1e, 0x84, 0x80}) // 2 ADA
+func ParseValueCBOR(data []byte) (Value, error) {
+	r := cborReader{data: data}
+	v, err := r.readValue()
+	if err != nil {
+		return nil, err
+	}
+	if r.pos != len(r.data) {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidCBOR, len(r.data)-r.pos)
+	}
+	return v, nil
+}
+
+// cborReader decodes the subset of CBOR used by ledger values.
+type cborReader struct {
+	data []byte
+	pos  int
+}
+
+// readHead reads an item head and returns its major type and argument.
+// Indefinite lengths and reserved additional information are rejected.
+func (r *cborReader) readHead() (byte, uint64, error) {
+	if r.pos >= len(r.data) {
+		return 0, 0, fmt.Errorf("%w: unexpected end of input", ErrInvalidCBOR)
+	}
+	b := r.data[r.pos]
+	r.pos++
+	major, info := b>>5, b&0x1f
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, fmt.Errorf("%w: unsupported additional information %d at offset %d", ErrInvalidCBOR, info, r.pos-1)
+	}
+	size := 1 << (info - 24)
+	if len(r.data)-r.pos < size {
+		return 0, 0, fmt.Errorf("%w: unexpected end of input", ErrInvalidCBOR)
+	}
+	var n uint64
+	for _, c := range r.data[r.pos : r.pos+size] {
+		n = n<<8 | uint64(c)
+	}
+	r.pos += size
+	return major, n, nil
+}
+
+// expect reads an item head and checks that it has the given major type.
+func (r *cborReader) expect(major byte) (uint64, error) {
+	got, n, err := r.readHead()
+	if err != nil {
+		return 0, err
+	}
+	if got != major {
+		return 0, fmt.Errorf("%w: major type %d, want %d", ErrInvalidCBOR, got, major)
+	}
+	return n, nil
+}
+
+// readBytes reads a byte string.
+func (r *cborReader) readBytes() ([]byte, error) {
+	n, err := r.expect(cborBytes)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.data)-r.pos) < n {
+		return nil, fmt.Errorf("%w: unexpected end of input", ErrInvalidCBOR)
+	}
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+// readValue reads a bare coin or a [coin, multiasset] pair.
+func (r *cborReader) readValue() (Value, error) {
+	major, n, err := r.readHead()
+	if err != nil {
+		return nil, err
+	}
+	v := Value{}
+	switch major {
+	case cborUnsigned:
+		if n > 0 {
+			v[Lovelace] = n
+		}
+		return v, nil
+	case cborArray:
+		if n != 2 {
+			return nil, fmt.Errorf("%w: value array has %d elements, want 2", ErrInvalidCBOR, n)
+		}
+	default:
+		return nil, fmt.Errorf("%w: value has major type %d", ErrInvalidCBOR, major)
+	}
+	coin, err := r.expect(cborUnsigned)
+	if err != nil {
+		return nil, err
+	}
+	if coin > 0 {
+		v[Lovelace] = coin
+	}
+	policies, err := r.expect(cborMap)
+	if err != nil {
+		return nil, err
+	}
+	// Keys are tracked separately from v: a repeated policy would otherwise
+	// merge into the first, and zero quantities never reach v at all.
+	seenPolicies := make(map[string]bool)
+	for i := uint64(0); i < policies; i++ {
+		policy, err := r.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		policyID := hex.EncodeToString(policy)
+		if seenPolicies[policyID] {
+			return nil, fmt.Errorf("%w: duplicate policy %s", ErrInvalidCBOR, policyID)
+		}
+		seenPolicies[policyID] = true
+		names, err := r.expect(cborMap)
+		if err != nil {
+			return nil, err
+		}
+		seenNames := make(map[string]bool)
+		for j := uint64(0); j < names; j++ {
+			name, err := r.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			amount, err := r.expect(cborUnsigned)
+			if err != nil {
+				return nil, err
+			}
+			a, err := NewAsset(policyID, string(name))
+			if err != nil {
+				return nil, err
+			}
+			if seenNames[a.AssetName] {
+				return nil, fmt.Errorf("%w: duplicate asset %s", ErrInvalidCBOR, a.AssetID())
+			}
+			seenNames[a.AssetName] = true
+			if amount > 0 {
+				v[a] = amount
+			}
+		}
+	}
+	return v, nil
+}
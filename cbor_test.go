@@ -0,0 +1,260 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMarshalMintCBOR(t *testing.T) {
+	const otherPolicy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	tests := []struct {
+		name    string
+		v       Value
+		want    string
+		wantErr error
+	}{
+		{
+			// Matches the mint field cardano-cli builds for
+			// --mint "1 <policy>.537061636542756430".
+			name: "single nft",
+			v:    Value{bud0: 1},
+			want: "a1581c" + testPolicy + "a149537061636542756430" + "01",
+		},
+		{
+			name: "canonical order, lovelace and zeros skipped",
+			v: Value{
+				Lovelace:                                5000000,
+				bud1:                                    1,
+				bud0:                                    1,
+				{PolicyID: otherPolicy}:                 1000,
+				{PolicyID: otherPolicy, AssetName: "x"}: 0,
+			},
+			want: "a2" +
+				"581c" + otherPolicy + "a1" + "40" + "1903e8" +
+				"581c" + testPolicy + "a2" + "49537061636542756430" + "01" + "49537061636542756431" + "01",
+		},
+		{name: "empty", v: Value{}, want: "a0"},
+		{name: "lovelace only", v: Value{Lovelace: 1}, want: "a0"},
+		{name: "overflow", v: Value{bud0: math.MaxInt64 + 1}, wantErr: ErrAmountOverflow},
+		{name: "invalid policy", v: Value{{PolicyID: "abcd", AssetName: "x"}: 1}, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.MarshalMintCBOR()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarshalMintCBOR() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && hex.EncodeToString(got) != tt.want {
+				t.Errorf("MarshalMintCBOR() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseValueCBOR(t *testing.T) {
+	const otherPolicy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	oneAsset := "821a001e8480" + "a1581c" + testPolicy + "a149537061636542756430" + "01"
+	tests := []struct {
+		name    string
+		in      string
+		want    Value
+		wantErr error
+	}{
+		{name: "lovelace only", in: "1a001e8480", want: Value{Lovelace: 2000000}},
+		{name: "zero coin", in: "00", want: Value{}},
+		{name: "one asset", in: oneAsset, want: Value{Lovelace: 2000000, bud0: 1}},
+		{
+			name: "two policies with empty name",
+			in: "8201" + "a2" +
+				"581c" + otherPolicy + "a1" + "40" + "1903e8" +
+				"581c" + testPolicy + "a1" + "49537061636542756430" + "05",
+			want: Value{Lovelace: 1, {PolicyID: otherPolicy}: 1000, bud0: 5},
+		},
+		{name: "zero quantity dropped", in: "8200a1581c" + testPolicy + "a14100" + "00", want: Value{}},
+		{name: "empty input", in: "", wantErr: ErrInvalidCBOR},
+		{name: "trailing bytes", in: "1a001e848000", wantErr: ErrInvalidCBOR},
+		{name: "truncated", in: oneAsset[:len(oneAsset)-4], wantErr: ErrInvalidCBOR},
+		{name: "indefinite array", in: "9f01a0ff", wantErr: ErrInvalidCBOR},
+		{name: "three-element array", in: "830100a0", wantErr: ErrInvalidCBOR},
+		{name: "negative coin", in: "20", wantErr: ErrInvalidCBOR},
+		{name: "text policy key", in: "8200a1" + "6161" + "a0", wantErr: ErrInvalidCBOR},
+		{
+			name:    "duplicate asset",
+			in:      "8200a1581c" + testPolicy + "a2" + "4141" + "01" + "4141" + "02",
+			wantErr: ErrInvalidCBOR,
+		},
+		{
+			name:    "duplicate zero-quantity asset",
+			in:      "8200a1581c" + testPolicy + "a2" + "4141" + "00" + "4141" + "02",
+			wantErr: ErrInvalidCBOR,
+		},
+		{
+			name: "duplicate policy",
+			in: "8200a2" +
+				"581c" + testPolicy + "a1" + "4141" + "01" +
+				"581c" + testPolicy + "a1" + "4142" + "02",
+			wantErr: ErrInvalidCBOR,
+		},
+		{
+			name: "duplicate policy with empty map",
+			in: "8200a2" +
+				"581c" + testPolicy + "a0" +
+				"581c" + testPolicy + "a0",
+			wantErr: ErrInvalidCBOR,
+		},
+		{name: "short policy", in: "8200a1420102" + "a14001", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ParseValueCBOR(data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseValueCBOR(%s) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseValueCBOR(%s) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalCBOR(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name    string
+		v       Value
+		want    string
+		wantErr error
+	}{
+		{name: "lovelace only", v: Value{Lovelace: 2000000}, want: "1a001e8480"},
+		{name: "empty", v: Value{}, want: "00"},
+		{name: "one asset", v: Value{Lovelace: 2000000, bud0: 1}, want: "821a001e8480" + "a1581c" + testPolicy + "a149537061636542756430" + "01"},
+		{name: "zero amount skipped", v: Value{Lovelace: 1, bud0: 0}, want: "01"},
+		{name: "invalid policy", v: Value{{PolicyID: "abcd", AssetName: "x"}: 1}, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.MarshalCBOR()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarshalCBOR() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("MarshalCBOR() = %x, want %s", got, tt.want)
+			}
+			back, err := ParseValueCBOR(got)
+			if err != nil {
+				t.Fatalf("ParseValueCBOR() error = %v", err)
+			}
+			if !reflect.DeepEqual(back, nonZero(tt.v)) {
+				t.Errorf("ParseValueCBOR(MarshalCBOR()) = %v, want %v", back, tt.v)
+			}
+		})
+	}
+}
+
+func TestSerializedSize(t *testing.T) {
+	manyNames := Value{}
+	for i := 0; i < 300; i++ {
+		manyNames[Asset{PolicyID: testPolicy, AssetName: fmt.Sprintf("n%03d", i)}] = uint64(i)
+	}
+	manyPolicies := Value{Lovelace: math.MaxUint64}
+	for i := 0; i < 30; i++ {
+		policy := fmt.Sprintf("%056x", i)
+		manyPolicies[Asset{PolicyID: policy, AssetName: "x"}] = 1 << (2 * i)
+	}
+	tests := []struct {
+		name string
+		v    Value
+	}{
+		{name: "empty", v: Value{}},
+		{name: "small coin", v: Value{Lovelace: 23}},
+		{name: "coin head boundaries", v: Value{Lovelace: 24}},
+		{name: "max coin", v: Value{Lovelace: math.MaxUint64}},
+		{name: "one asset", v: Value{Lovelace: 2000000, {PolicyID: testPolicy, AssetName: "SpaceBud0"}: 1}},
+		{name: "empty name", v: Value{{PolicyID: testPolicy}: 65536}},
+		{
+			name: "name length boundaries",
+			v: Value{
+				{PolicyID: testPolicy, AssetName: strings.Repeat("a", 23)}: 255,
+				{PolicyID: testPolicy, AssetName: strings.Repeat("b", 24)}: 256,
+				{PolicyID: testPolicy, AssetName: strings.Repeat("c", 32)}: 1 << 32,
+			},
+		},
+		{name: "zero amounts skipped", v: Value{Lovelace: 0, {PolicyID: testPolicy, AssetName: "x"}: 0}},
+		{name: "300 names in one policy", v: manyNames},
+		{name: "30 policies", v: manyPolicies},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.v.MarshalCBOR()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.v.SerializedSize(); got != len(data) {
+				t.Errorf("SerializedSize() = %d, want len(MarshalCBOR()) = %d", got, len(data))
+			}
+		})
+	}
+}
+
+func TestValueHash(t *testing.T) {
+	const otherPolicy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	patate := Asset{PolicyID: otherPolicy, AssetName: "PATATE"}
+
+	// Blake2b-256 of the MarshalCBOR vector for {2 ADA, SpaceBud0}.
+	const want = "d8b94d7fd43c40f2a8350516e9381ab7d75a64f6ac26f4be97bebd24cdfa2117"
+	if got := hex.EncodeToString(Value{Lovelace: 2000000, bud0: 1}.Hash()); got != want {
+		t.Errorf("Hash() = %s, want %s", got, want)
+	}
+
+	forward := Value{}
+	for _, a := range []Asset{Lovelace, bud0, bud1, patate} {
+		forward[a] = 7
+	}
+	backward := Value{}
+	for _, a := range []Asset{patate, bud1, bud0, Lovelace} {
+		backward[a] = 7
+	}
+	tests := []struct {
+		name      string
+		a, b      Value
+		wantEqual bool
+	}{
+		{name: "insertion order ignored", a: forward, b: backward, wantEqual: true},
+		{name: "zero entries ignored", a: Value{Lovelace: 1}, b: Value{Lovelace: 1, bud0: 0}, wantEqual: true},
+		{name: "amount differs", a: Value{bud0: 1}, b: Value{bud0: 2}, wantEqual: false},
+		{name: "asset differs", a: Value{bud0: 1}, b: Value{bud1: 1}, wantEqual: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ha, hb := tt.a.Hash(), tt.b.Hash()
+			if len(ha) != blake2b256Size || len(hb) != blake2b256Size {
+				t.Fatalf("Hash() lengths = %d, %d, want %d", len(ha), len(hb), blake2b256Size)
+			}
+			if got := bytes.Equal(ha, hb); got != tt.wantEqual {
+				t.Errorf("Hash() equal = %v, want %v (%x vs %x)", got, tt.wantEqual, ha, hb)
+			}
+		})
+	}
+
+	if h := (Value{{PolicyID: "abcd", AssetName: "x"}: 1}).Hash(); h != nil {
+		t.Errorf("Hash() of invalid Value = %x, want nil", h)
+	}
+}
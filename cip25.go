@@ -0,0 +1,91 @@
+package cardanoasset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// cip25Label is the transaction metadata label CIP-25 NFT metadata lives under.
+const cip25Label = "721"
+
+var (
+	// ErrInvalidCIP25Name is returned when an asset name cannot be used as a
+	// CIP-25 metadata key.
+	ErrInvalidCIP25Name = errors.New("asset name is not a valid CIP-25 key: must be non-empty UTF-8")
+	// ErrDuplicateMetadata is returned when an asset is added to a metadata
+	// builder twice.
+	ErrDuplicateMetadata = errors.New("duplicate asset metadata")
+)
+
+// CIP25Metadata builds the CIP-25 "721" transaction metadata for a mint,
+// keyed by policy ID and then by the UTF-8 asset name. The zero value is
+// ready to use. A CIP25Metadata is not safe for concurrent use.
+type CIP25Metadata struct {
+	policies map[string]map[string]map[string]interface{}
+}
+
+// Add records the metadata fields for asset a. fields is copied, though
+// nested values are shared.
+// Returns the asset's validation error if it is malformed, ErrInvalidCIP25Name
+// if its name is not IsCIP25Compatible, or
+// ErrDuplicateMetadata if a was already added.
+//
+// Example:
+//
+//	var md cardanoasset.CIP25Metadata
+//	err := md.Add(a, map[string]interface{}{"name": "SpaceBud #0", "image": "ipfs://..."})
+func (m *CIP25Metadata) Add(a Asset, fields map[string]interface{}) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	if !a.IsCIP25Compatible() {
+		return newValidationError("assetName", a.AssetName, ErrInvalidCIP25Name)
+	}
+	if m.policies == nil {
+		m.policies = make(map[string]map[string]map[string]interface{})
+	}
+	names := m.policies[a.PolicyID]
+	if names == nil {
+		names = make(map[string]map[string]interface{})
+		m.policies[a.PolicyID] = names
+	}
+	if _, dup := names[a.AssetName]; dup {
+		return fmt.Errorf("%w: %s", ErrDuplicateMetadata, a.AssetID())
+	}
+	copied := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		copied[k] = v
+	}
+	names[a.AssetName] = copied
+	return nil
+}
+
+// IsCIP25Compatible reports whether the asset name can be represented in
+// CIP-25 metadata, which uses it as a JSON map key: it must be non-empty and
+// valid UTF-8. The ledger itself allows arbitrary bytes, so check this before
+// minting a token meant to carry CIP-25 metadata.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	ok := a.IsCIP25Compatible() // true
+func (a Asset) IsCIP25Compatible() bool {
+	return a.AssetName != "" && utf8.ValidString(a.AssetName)
+}
+
+// JSON encodes the metadata as {"721": {policyId: {assetName: {...}}}} with
+// keys sorted, so equal builders produce identical output.
+// Returns the encoding/json error if a field value cannot be marshaled.
+//
+// Example:
+//
+//	body, err := md.JSON()
+func (m *CIP25Metadata) JSON() ([]byte, error) {
+	policies := m.policies
+	if policies == nil {
+		policies = map[string]map[string]map[string]interface{}{}
+	}
+	return json.Marshal(map[string]interface{}{cip25Label: policies})
+}
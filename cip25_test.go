@@ -0,0 +1,104 @@
+package cardanoasset
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCIP25Metadata(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+
+	var md CIP25Metadata
+	if err := md.Add(bud1, map[string]interface{}{"name": "SpaceBud #1"}); err != nil {
+		t.Fatalf("Add(bud1) error = %v", err)
+	}
+	fields := map[string]interface{}{"name": "SpaceBud #0", "image": "ipfs://QmSpaceBud0"}
+	if err := md.Add(bud0, fields); err != nil {
+		t.Fatalf("Add(bud0) error = %v", err)
+	}
+	fields["name"] = "mutated after Add"
+
+	got, err := md.JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+	want := `{"721":{"` + testPolicy + `":{` +
+		`"SpaceBud0":{"image":"ipfs://QmSpaceBud0","name":"SpaceBud #0"},` +
+		`"SpaceBud1":{"name":"SpaceBud #1"}}}}`
+	if string(got) != want {
+		t.Errorf("JSON() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCIP25MetadataAddErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		asset   Asset
+		wantErr error
+	}{
+		{name: "binary name", asset: Asset{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40\xff"}, wantErr: ErrInvalidCIP25Name},
+		{name: "empty name", asset: Asset{PolicyID: testPolicy}, wantErr: ErrInvalidCIP25Name},
+		{name: "invalid policy", asset: Asset{PolicyID: "abcd", AssetName: "SpaceBud0"}, wantErr: ErrInvalidPolicyID},
+		{name: "duplicate", asset: Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}, wantErr: ErrDuplicateMetadata},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var md CIP25Metadata
+			if err := md.Add(Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}, nil); err != nil {
+				t.Fatal(err)
+			}
+			err := md.Add(tt.asset, map[string]interface{}{"name": "x"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrInvalidCIP25Name) {
+				var ve *ValidationError
+				if !errors.As(err, &ve) || ve.Field != "assetName" {
+					t.Errorf("Add() error = %#v, want *ValidationError for assetName", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCIP25MetadataJSONEdgeCases(t *testing.T) {
+	var empty CIP25Metadata
+	got, err := empty.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != `{"721":{}}` {
+		t.Errorf("JSON() of empty builder = %s, want {\"721\":{}}", got)
+	}
+
+	var md CIP25Metadata
+	if err := md.Add(Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}, map[string]interface{}{"bad": make(chan int)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := md.JSON(); err == nil {
+		t.Error("JSON() with an unmarshalable field succeeded, want error")
+	}
+}
+
+func TestIsCIP25Compatible(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+		want      bool
+	}{
+		{name: "utf-8 name", assetName: "SpaceBud0", want: true},
+		{name: "multibyte utf-8", assetName: "Café 🚀", want: true},
+		{name: "binary name", assetName: "\x00\x0d\xe1\x40\xff", want: false},
+		{name: "invalid utf-8", assetName: "\xc3\x28", want: false},
+		{name: "empty name", assetName: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			if got := a.IsCIP25Compatible(); got != tt.want {
+				t.Errorf("IsCIP25Compatible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
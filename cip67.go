@@ -0,0 +1,212 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// CIP-67 asset name labels defined by CIP-68 for datum-backed tokens.
+//
+// Reference: https://cips.cardano.org/cip/CIP-67
+// Reference: https://cips.cardano.org/cip/CIP-68
+const (
+	// LabelReferenceNFT (100) marks the reference token that carries the datum.
+	LabelReferenceNFT uint16 = 100
+	// LabelNFT (222) marks a CIP-68 non-fungible user token.
+	LabelNFT uint16 = 222
+	// LabelFT (333) marks a CIP-68 fungible user token.
+	LabelFT uint16 = 333
+	// LabelRFT (444) marks a CIP-68 rich-fungible user token.
+	LabelRFT uint16 = 444
+
+	// cip67PrefixLength is the byte length of an encoded CIP-67 label prefix.
+	cip67PrefixLength = 4
+)
+
+// Error types for CIP-67/68 label handling.
+var (
+	ErrNoCIP67Label  = errors.New("asset name has no valid CIP-67 label prefix")
+	ErrNotCIP68Label = errors.New("CIP-67 label is not a CIP-68 token label")
+)
+
+// Label decodes the CIP-67 label prefix of the asset name. It reports false
+// when the name is shorter than 4 bytes, the padding nibbles are non-zero, or
+// the CRC-8 checksum does not match.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	label, ok := a.Label() // 222, true
+func (a Asset) Label() (uint16, bool) {
+	return decodeCIP67Label([]byte(a.AssetName))
+}
+
+// IsReferenceToken reports whether the asset name carries the CIP-68
+// reference token label (100). It returns false for unlabeled names.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000643b04e4654")
+//	ok := a.IsReferenceToken() // true
+func (a Asset) IsReferenceToken() bool {
+	label, ok := a.Label()
+	return ok && label == LabelReferenceNFT
+}
+
+// IsUserToken reports whether the asset name carries a CIP-68 user token
+// label: 222 (NFT), 333 (FT) or 444 (RFT). It returns false for unlabeled
+// names and for reference tokens.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	ok := a.IsUserToken() // true
+func (a Asset) IsUserToken() bool {
+	label, ok := a.Label()
+	return ok && (label == LabelNFT || label == LabelFT || label == LabelRFT)
+}
+
+// CounterpartLabels returns the labels of the token(s) that complete the
+// CIP-68 pair this asset belongs to. A user token (222, 333 or 444) expects the
+// 100 reference token; a reference token may be paired with any user label, so
+// all three are returned. Returns ErrNoCIP67Label for unlabeled names and
+// ErrNotCIP68Label for CIP-67 labels outside the CIP-68 set.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	labels, err := a.CounterpartLabels() // []uint16{100}
+func (a Asset) CounterpartLabels() ([]uint16, error) {
+	label, ok := a.Label()
+	if !ok {
+		return nil, ErrNoCIP67Label
+	}
+	switch label {
+	case LabelReferenceNFT:
+		return []uint16{LabelNFT, LabelFT, LabelRFT}, nil
+	case LabelNFT, LabelFT, LabelRFT:
+		return []uint16{LabelReferenceNFT}, nil
+	default:
+		return nil, ErrNotCIP68Label
+	}
+}
+
+// ReferenceTokenFor returns the CIP-68 reference token (label 100) that holds
+// the datum for user token a: the same policy and content with the label
+// prefix rewritten. A reference token maps to itself.
+// Returns ErrNoCIP67Label for unlabeled names and ErrNotCIP68Label for
+// CIP-67 labels outside the CIP-68 set.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	ref, err := cardanoasset.ReferenceTokenFor(a) // name hex "000643b04e4654"
+func ReferenceTokenFor(a Asset) (Asset, error) {
+	if _, err := a.CounterpartLabels(); err != nil {
+		return Asset{}, err
+	}
+	name := string(encodeCIP67Label(LabelReferenceNFT)) + a.AssetName[cip67PrefixLength:]
+	return Asset{PolicyID: a.PolicyID, AssetName: name}, nil
+}
+
+// CIP68ReferenceUnit returns the concatenated unit of the reference token
+// for a, the asset a smart contract reads as a reference input to find a's
+// datum. It is ReferenceTokenFor followed by Unit.
+// Returns the same errors as ReferenceTokenFor.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	unit, err := a.CIP68ReferenceUnit() // "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc000643b04e4654"
+func (a Asset) CIP68ReferenceUnit() (string, error) {
+	ref, err := ReferenceTokenFor(a)
+	if err != nil {
+		return "", err
+	}
+	return ref.Unit(), nil
+}
+
+// NewAssetFromParts assembles an Asset from raw policy ID bytes, a CIP-67
+// label and the name content that follows the label prefix.
+// Returns ErrInvalidPolicyID if policyBytes is not 28 bytes and
+// ErrAssetNameTooLong if the 4-byte prefix plus content exceeds 32 bytes.
+//
+// Example:
+//
+//	a, err := cardanoasset.NewAssetFromParts(policyBytes, cardanoasset.LabelNFT, []byte("NFT"))
+func NewAssetFromParts(policyBytes []byte, label uint16, content []byte) (Asset, error) {
+	if len(policyBytes) != PolicyIDLength {
+		return Asset{}, ErrInvalidPolicyID
+	}
+	if cip67PrefixLength+len(content) > MaxAssetNameLength {
+		return Asset{}, ErrAssetNameTooLong
+	}
+	name := append(encodeCIP67Label(label), content...)
+	return Asset{PolicyID: hex.EncodeToString(policyBytes), AssetName: string(name)}, nil
+}
+
+// ParseCIP68 splits a hex-encoded asset name into its CIP-67 label and the
+// content bytes after the 4-byte prefix. It is the inverse of the name
+// assembly done by NewAssetFromParts.
+// Returns ErrInvalidHex for malformed hex and ErrNoCIP67Label if the name has
+// no valid label prefix.
+//
+// Example:
+//
+//	label, content, err := cardanoasset.ParseCIP68("000de1404e4654") // 222, []byte("NFT")
+func ParseCIP68(assetNameHex string) (label uint16, content []byte, err error) {
+	name, err := hex.DecodeString(assetNameHex)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	label, ok := decodeCIP67Label(name)
+	if !ok {
+		return 0, nil, ErrNoCIP67Label
+	}
+	return label, name[cip67PrefixLength:], nil
+}
+
+// encodeCIP67Label returns the 4-byte CIP-67 prefix for label:
+// a zero nibble, the 16-bit label, its CRC-8 checksum, and a zero nibble.
+func encodeCIP67Label(label uint16) []byte {
+	crc := crc8([]byte{byte(label >> 8), byte(label)})
+	return []byte{
+		byte(label >> 12),
+		byte(label >> 4),
+		byte(label<<4) | crc>>4,
+		crc << 4,
+	}
+}
+
+// decodeCIP67Label parses and verifies the CIP-67 prefix of name.
+func decodeCIP67Label(name []byte) (uint16, bool) {
+	if len(name) < cip67PrefixLength {
+		return 0, false
+	}
+	if name[0]>>4 != 0 || name[3]&0x0f != 0 {
+		return 0, false
+	}
+	label := uint16(name[0])<<12 | uint16(name[1])<<4 | uint16(name[2])>>4
+	crc := name[2]<<4 | name[3]>>4
+	if crc8([]byte{byte(label >> 8), byte(label)}) != crc {
+		return 0, false
+	}
+	return label, true
+}
+
+// crc8 computes the CRC-8 checksum (polynomial 0x07, zero init) used by CIP-67.
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
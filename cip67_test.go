@@ -0,0 +1,156 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCounterpartLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		want    []uint16
+		wantErr error
+	}{
+		{name: "222 user token", nameHex: "000de1404e4654", want: []uint16{LabelReferenceNFT}},
+		{name: "333 user token", nameHex: "0014df104654", want: []uint16{LabelReferenceNFT}},
+		{name: "100 reference token", nameHex: "000643b04e4654", want: []uint16{LabelNFT, LabelFT, LabelRFT}},
+		{name: "unlabeled", nameHex: "4e4654", wantErr: ErrNoCIP67Label},
+		{name: "non-CIP-68 label", nameHex: "00001070", wantErr: ErrNotCIP68Label},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustAssetFromHex(t, testPolicy, tt.nameHex).CounterpartLabels()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CounterpartLabels() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CounterpartLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAssetFromParts(t *testing.T) {
+	policyBytes, err := hex.DecodeString(testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		policyBytes []byte
+		label       uint16
+		content     []byte
+		wantNameHex string
+		wantErr     error
+	}{
+		{name: "222 nft", policyBytes: policyBytes, label: LabelNFT, content: []byte("NFT"), wantNameHex: "000de1404e4654"},
+		{name: "100 reference", policyBytes: policyBytes, label: LabelReferenceNFT, content: []byte("NFT"), wantNameHex: "000643b04e4654"},
+		{name: "label 1 empty content", policyBytes: policyBytes, label: 1, wantNameHex: "00001070"},
+		{name: "28 bytes of content", policyBytes: policyBytes, label: LabelFT, content: bytes.Repeat([]byte{0xab}, 28), wantNameHex: "0014df10" + strings.Repeat("ab", 28)},
+		{name: "over-long content", policyBytes: policyBytes, label: LabelNFT, content: make([]byte, 29), wantErr: ErrAssetNameTooLong},
+		{name: "short policy", policyBytes: policyBytes[:27], label: LabelNFT, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAssetFromParts(tt.policyBytes, tt.label, tt.content)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewAssetFromParts error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if a.PolicyID != testPolicy || a.AssetNameHex() != tt.wantNameHex {
+				t.Fatalf("NewAssetFromParts = %s.%s, want %s.%s", a.PolicyID, a.AssetNameHex(), testPolicy, tt.wantNameHex)
+			}
+			label, content, err := ParseCIP68(a.AssetNameHex())
+			if err != nil {
+				t.Fatalf("ParseCIP68 error = %v", err)
+			}
+			if label != tt.label || !bytes.Equal(content, tt.content) {
+				t.Errorf("ParseCIP68 = (%d, %x), want (%d, %x)", label, content, tt.label, tt.content)
+			}
+		})
+	}
+}
+
+func TestParseCIP68Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		wantErr error
+	}{
+		{name: "bad hex", nameHex: "zz", wantErr: ErrInvalidHex},
+		{name: "unlabeled", nameHex: "537061636542756430", wantErr: ErrNoCIP67Label},
+		{name: "bad checksum", nameHex: "000de1414e4654", wantErr: ErrNoCIP67Label},
+		{name: "too short", nameHex: "000de1", wantErr: ErrNoCIP67Label},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ParseCIP68(tt.nameHex); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCIP68(%q) error = %v, want %v", tt.nameHex, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenPredicates(t *testing.T) {
+	tests := []struct {
+		name          string
+		nameHex       string
+		wantReference bool
+		wantUser      bool
+	}{
+		{name: "100 reference", nameHex: "000643b04e4654", wantReference: true},
+		{name: "222 nft", nameHex: "000de1404e4654", wantUser: true},
+		{name: "333 ft", nameHex: "0014df104654", wantUser: true},
+		{name: "444 rft", nameHex: "001bc2804e4654", wantUser: true},
+		{name: "plain name", nameHex: "537061636542756430"},
+		{name: "non-CIP-68 label", nameHex: "00001070"},
+		{name: "bad prefix checksum", nameHex: "000de1504e4654"},
+		{name: "empty name", nameHex: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustAssetFromHex(t, testPolicy, tt.nameHex)
+			if got := a.IsReferenceToken(); got != tt.wantReference {
+				t.Errorf("IsReferenceToken() = %v, want %v", got, tt.wantReference)
+			}
+			if got := a.IsUserToken(); got != tt.wantUser {
+				t.Errorf("IsUserToken() = %v, want %v", got, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestCIP68ReferenceUnit(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		want    string
+		wantErr error
+	}{
+		{name: "222 nft", nameHex: "000de1404e4654", want: testPolicy + "000643b04e4654"},
+		{name: "333 ft", nameHex: "0014df104654", want: testPolicy + "000643b04654"},
+		{name: "444 rft", nameHex: "001bc2804e4654", want: testPolicy + "000643b04e4654"},
+		{name: "reference maps to itself", nameHex: "000643b04e4654", want: testPolicy + "000643b04e4654"},
+		{name: "label only", nameHex: "000de140", want: testPolicy + "000643b0"},
+		{name: "unlabeled", nameHex: "537061636542756430", wantErr: ErrNoCIP67Label},
+		{name: "non-CIP-68 label", nameHex: "00001070", wantErr: ErrNotCIP68Label},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustAssetFromHex(t, testPolicy, tt.nameHex).CIP68ReferenceUnit()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CIP68ReferenceUnit() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CIP68ReferenceUnit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fingerprintSetHRP is the bech32 HRP of FingerprintSetDigest output.
+const fingerprintSetHRP = "fpset"
+
+// MaxSeriesCount is the largest count GenerateSeries accepts. It is well above
+// any real collection size and bounds the memory a single call can allocate.
+const MaxSeriesCount = 1_000_000
+
+// Error types for collection utilities.
+var (
+	ErrEmptyCollectionRoot = errors.New("collection root is empty")
+	ErrInvalidSeriesRange  = errors.New("invalid series range: start and count must be non-negative")
+	ErrGapsTruncated       = errors.New("missing-number list truncated")
+)
+
+// RNGFromRoot returns a deterministic pseudo-random generator seeded from a
+// hex-encoded collection root (for example a published Merkle root of the
+// collection's metadata). The same root always yields the same stream, so
+// reveal orders derived from it can be reproduced and audited by anyone.
+// The seed is the first 8 bytes of SHA-256 over the decoded root, so every
+// byte of the root influences the stream.
+//
+// The generator is math/rand and is NOT suitable for secrets; its purpose is
+// reproducibility, with fairness coming from the root being committed publicly
+// before the reveal. A *rand.Rand is not safe for concurrent use.
+//
+// Example:
+//
+//	rng, err := cardanoasset.RNGFromRoot("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+//	order := rng.Perm(10000)
+func RNGFromRoot(root string) (*rand.Rand, error) {
+	rootBytes, err := hex.DecodeString(root)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	if len(rootBytes) == 0 {
+		return nil, ErrEmptyCollectionRoot
+	}
+	sum := sha256.Sum256(rootBytes)
+	seed := int64(binary.BigEndian.Uint64(sum[:8]))
+	return rand.New(rand.NewSource(seed)), nil
+}
+
+// IsContiguousRange checks that the numbered names prefix+N among assets
+// cover every number from 0 up to the highest number found. It returns the
+// missing numbers in ascending order and ok=true when there are none.
+// Names without the prefix, or whose suffix is not a canonical decimal number
+// (digits only, no leading zeros, so "07" is ignored rather than read as 7),
+// are ignored, as are repeated numbers. If no name matches, ok is false.
+// At most len(assets) missing numbers are returned, so a single stray name
+// such as SpaceBud99999999 cannot force an allocation the size of the gap;
+// use IsContiguousRangeFrom to detect that case or to start at another number.
+//
+// Example:
+//
+//	missing, ok := cardanoasset.IsContiguousRange(assets, "SpaceBud")
+func IsContiguousRange(assets []Asset, prefix string) (missing []uint64, ok bool) {
+	missing, ok, _ = IsContiguousRangeFrom(assets, prefix, 0)
+	return missing, ok
+}
+
+// IsContiguousRangeFrom is IsContiguousRange for a collection numbered from
+// start, such as one that begins at 1. Numbers below start are ignored.
+// If more numbers are missing than there are assets, missing holds the first
+// len(assets) of them, ok is false, and err wraps ErrGapsTruncated with the
+// total count; err is nil otherwise.
+//
+// Example:
+//
+//	missing, ok, err := cardanoasset.IsContiguousRangeFrom(assets, "SpaceBud", 1)
+func IsContiguousRangeFrom(assets []Asset, prefix string, start uint64) (missing []uint64, ok bool, err error) {
+	numbers := make([]uint64, 0, len(assets))
+	for _, a := range assets {
+		suffix, found := strings.CutPrefix(a.AssetName, prefix)
+		if !found || (len(suffix) > 1 && suffix[0] == '0') {
+			continue
+		}
+		n, err := strconv.ParseUint(suffix, 10, 64)
+		if err != nil || n < start {
+			continue
+		}
+		numbers = append(numbers, n)
+	}
+	if len(numbers) == 0 {
+		return nil, false, nil
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	unique := numbers[:1]
+	for _, n := range numbers[1:] {
+		if n != unique[len(unique)-1] {
+			unique = append(unique, n)
+		}
+	}
+	// Every unique number lies in [start, max], so this cannot underflow, and
+	// it avoids computing the span max-start+1, which overflows for a full
+	// uint64 range.
+	gaps := unique[len(unique)-1] - start - uint64(len(unique)-1)
+	limit := uint64(len(assets))
+	if gaps > limit {
+		err = fmt.Errorf("%w: %d numbers missing, first %d returned", ErrGapsTruncated, gaps, limit)
+	}
+	next := start
+	for _, n := range unique {
+		for ; next < n && uint64(len(missing)) < limit; next++ {
+			missing = append(missing, next)
+		}
+		if uint64(len(missing)) == limit && gaps > limit {
+			break
+		}
+		// next wraps to 0 after math.MaxUint64, which is necessarily the last
+		// unique number, so the loop ends before it is read again.
+		next = n + 1
+	}
+	return missing, gaps == 0, err
+}
+
+// FingerprintSetDigest returns a compact bech32 digest (HRP "fpset") of a set
+// of assets, so two parties can check whether they hold the same set by
+// exchanging one short string. The fingerprint hash of each asset is
+// computed, the hashes are sorted and de-duplicated, and their concatenation
+// is hashed with the fingerprint hasher. The digest is therefore independent
+// of input order and of duplicate entries.
+// Returns the validation error of the first invalid asset.
+//
+// Example:
+//
+//	digest, err := cardanoasset.FingerprintSetDigest(assets) // "fpset1..."
+func FingerprintSetDigest(assets []Asset) (string, error) {
+	hashes := make([][]byte, 0, len(assets))
+	for i, a := range assets {
+		if err := a.Validate(); err != nil {
+			return "", fmt.Errorf("asset %d: %w", i, err)
+		}
+		policyBytes, err := hex.DecodeString(a.PolicyID)
+		if err != nil {
+			return "", fmt.Errorf("asset %d: %w: %v", i, ErrInvalidHex, err)
+		}
+		hashes = append(hashes, blake2b160(append(policyBytes, a.AssetName...)))
+	}
+	sort.Slice(hashes, func(i, j int) bool { return bytes.Compare(hashes[i], hashes[j]) < 0 })
+	set := make([]byte, 0, len(hashes)*fingerprintHashLength)
+	for i, h := range hashes {
+		if i > 0 && bytes.Equal(h, hashes[i-1]) {
+			continue
+		}
+		set = append(set, h...)
+	}
+	return bech32Encode(fingerprintSetHRP, blake2b160(set))
+}
+
+// CoveringPolicies returns the distinct policy IDs of assets, ordered by
+// descending number of assets under each policy so the largest collections
+// come first when batching policy-filtered queries. Ties are broken by
+// ascending policy ID.
+//
+// Example:
+//
+//	for _, policyID := range cardanoasset.CoveringPolicies(assets) {
+//	    // query by policy
+//	}
+func CoveringPolicies(assets []Asset) []string {
+	counts := make(map[string]int)
+	for _, a := range assets {
+		counts[a.PolicyID]++
+	}
+	policies := make([]string, 0, len(counts))
+	for policyID := range counts {
+		policies = append(policies, policyID)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if counts[policies[i]] != counts[policies[j]] {
+			return counts[policies[i]] > counts[policies[j]]
+		}
+		return policies[i] < policies[j]
+	})
+	return policies
+}
+
+// DedupAssets returns assets with duplicates removed, keeping the first
+// occurrence of each and preserving input order. Assets are equal when they
+// share the policy ID and the decoded name bytes. Because Asset always stores
+// the decoded name, an asset built with NewAsset(p, "SpaceBud0") and one built
+// with NewAssetFromHex(p, "537061636542756430") collapse to one.
+//
+// Example:
+//
+//	unique := cardanoasset.DedupAssets(assets)
+func DedupAssets(assets []Asset) []Asset {
+	seen := make(map[Asset]struct{}, len(assets))
+	unique := make([]Asset, 0, len(assets))
+	for _, a := range assets {
+		if _, ok := seen[a]; ok {
+			continue
+		}
+		seen[a] = struct{}{}
+		unique = append(unique, a)
+	}
+	return unique
+}
+
+// SortAssets sorts assets in place in canonical ledger order: by policy ID,
+// then by name length, then by name bytes, as used by Value.Assets.
+//
+// Example:
+//
+//	cardanoasset.SortAssets(assets)
+func SortAssets(assets []Asset) {
+	sort.Slice(assets, func(i, j int) bool {
+		return compareCanonical(assets[i], assets[j]) < 0
+	})
+}
+
+// DeltaEncode returns the assets present in updated but not base (added) and
+// those present in base but not updated (removed), walking both lists once
+// in O(n+m) time.
+//
+// Both inputs MUST already be sorted in canonical order, for example with
+// SortAssets; unsorted input gives meaningless results.
+//
+// Example:
+//
+//	cardanoasset.SortAssets(base)
+//	cardanoasset.SortAssets(updated)
+//	added, removed := cardanoasset.DeltaEncode(base, updated)
+func DeltaEncode(base, updated []Asset) (added []Asset, removed []Asset) {
+	i, j := 0, 0
+	for i < len(base) && j < len(updated) {
+		switch c := compareCanonical(base[i], updated[j]); {
+		case c < 0:
+			removed = append(removed, base[i])
+			i++
+		case c > 0:
+			added = append(added, updated[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	removed = append(removed, base[i:]...)
+	added = append(added, updated[j:]...)
+	return added, removed
+}
+
+// GenerateSeries returns count assets under policyID named prefix+N for N
+// from start to start+count-1, e.g. "SpaceBud0".."SpaceBud9999". Each name is
+// checked against the 32-byte limit, which long prefixes combined with large
+// numbers can exceed.
+// Returns ErrInvalidPolicyID, ErrInvalidSeriesRange for a negative start or
+// count, a count above MaxSeriesCount or a range running past math.MaxInt,
+// or an error wrapping ErrAssetNameTooLong that names the first index whose
+// name does not fit. Range errors are returned before anything is allocated.
+//
+// Example:
+//
+//	assets, err := cardanoasset.GenerateSeries(
+//	    "d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc",
+//	    "SpaceBud", 0, 10000,
+//	)
+func GenerateSeries(policyID, prefix string, start, count int) ([]Asset, error) {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return nil, err
+	}
+	if start < 0 || count < 0 {
+		return nil, ErrInvalidSeriesRange
+	}
+	if count > MaxSeriesCount {
+		return nil, fmt.Errorf("%w: count %d exceeds %d", ErrInvalidSeriesRange, count, MaxSeriesCount)
+	}
+	if count > math.MaxInt-start {
+		return nil, fmt.Errorf("%w: start %d + count %d overflows int", ErrInvalidSeriesRange, start, count)
+	}
+	assets := make([]Asset, 0, count)
+	for i := start; i < start+count; i++ {
+		name := prefix + strconv.Itoa(i)
+		if len(name) > MaxAssetNameLength {
+			return nil, fmt.Errorf("index %d (%q): %w", i, name, ErrAssetNameTooLong)
+		}
+		assets = append(assets, Asset{PolicyID: policyID, AssetName: name})
+	}
+	return assets, nil
+}
+
+// SameName reports whether a and b have identical asset name bytes,
+// ignoring their policies. Use it to match the "same" token across policies,
+// for example an NFT number in a collection migrated to a new policy. It is
+// not asset equality: compare Asset values with == for that.
+//
+// Example:
+//
+//	migrated := cardanoasset.SameName(oldBud, newBud)
+func SameName(a, b Asset) bool {
+	return a.AssetName == b.AssetName
+}
+
+// GroupByName groups assets by name regardless of policy, keyed by the
+// hex-encoded name so binary names are safe map keys. Within each group the
+// input order is preserved.
+//
+// Example:
+//
+//	for nameHex, group := range cardanoasset.GroupByName(assets) {
+//	    fmt.Println(nameHex, len(group))
+//	}
+func GroupByName(assets []Asset) map[string][]Asset {
+	groups := make(map[string][]Asset)
+	for _, a := range assets {
+		key := a.AssetNameHex()
+		groups[key] = append(groups[key], a)
+	}
+	return groups
+}
+
+// ValidateAssets runs Validate on every asset and collects all failures
+// instead of stopping at the first, for bulk uploads where each bad row
+// should be reported. errs has one entry per asset, nil for valid ones, and
+// anyInvalid reports whether any entry is non-nil.
+//
+// Example:
+//
+//	errs, anyInvalid := cardanoasset.ValidateAssets(rows)
+//	if anyInvalid {
+//	    for i, err := range errs {
+//	        if err != nil {
+//	            log.Printf("row %d: %v", i, err)
+//	        }
+//	    }
+//	}
+func ValidateAssets(assets []Asset) (errs []error, anyInvalid bool) {
+	errs = make([]error, len(assets))
+	for i, a := range assets {
+		if err := a.Validate(); err != nil {
+			errs[i] = err
+			anyInvalid = true
+		}
+	}
+	return errs, anyInvalid
+}
+
+// ParseUnits parses every unit with ParseUnit, collecting failures instead of
+// stopping at the first. Both results are parallel to units: assets[i] is the
+// parsed asset, or the zero Asset when errs[i] is non-nil.
+//
+// Example:
+//
+//	assets, errs := cardanoasset.ParseUnits(units)
+//	for i, err := range errs {
+//	    if err != nil {
+//	        log.Printf("unit %q: %v", units[i], err)
+//	    }
+//	}
+func ParseUnits(units []string) ([]Asset, []error) {
+	assets := make([]Asset, len(units))
+	errs := make([]error, len(units))
+	for i, unit := range units {
+		assets[i], errs[i] = ParseUnit(unit)
+	}
+	return assets, errs
+}
+
+// SamePolicy reports whether all assets share one policy ID and returns it.
+// It returns ("", false) for an empty slice or when policies differ, so
+// collection-scoped batch operations can guard their input.
+//
+// Example:
+//
+//	policyID, ok := cardanoasset.SamePolicy(assets)
+//	if !ok {
+//	    return errors.New("assets span several collections")
+//	}
+func SamePolicy(assets []Asset) (string, bool) {
+	if len(assets) == 0 {
+		return "", false
+	}
+	policyID := assets[0].PolicyID
+	for _, a := range assets[1:] {
+		if a.PolicyID != policyID {
+			return "", false
+		}
+	}
+	return policyID, true
+}
+
+// MapAssets returns fn applied to each asset, in input order.
+//
+// Example:
+//
+//	units := cardanoasset.MapAssets(assets, cardanoasset.Asset.Unit)
+func MapAssets[T any](assets []Asset, fn func(Asset) T) []T {
+	out := make([]T, len(assets))
+	for i, a := range assets {
+		out[i] = fn(a)
+	}
+	return out
+}
+
+// FilterAssets returns the assets for which pred returns true, preserving
+// input order. The input slice is not modified.
+//
+// Example:
+//
+//	textual := cardanoasset.FilterAssets(assets, cardanoasset.Asset.IsValidUTF8Name)
+func FilterAssets(assets []Asset, pred func(Asset) bool) []Asset {
+	var out []Asset
+	for _, a := range assets {
+		if pred(a) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
@@ -0,0 +1,662 @@
+package cardanoasset
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRNGFromRoot(t *testing.T) {
+	const root = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	tests := []struct {
+		name    string
+		root    string
+		wantErr error
+	}{
+		{name: "32-byte root", root: root},
+		{name: "short root", root: "00"},
+		{name: "empty", root: "", wantErr: ErrEmptyCollectionRoot},
+		{name: "bad hex", root: "zz", wantErr: ErrInvalidHex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r1, err := RNGFromRoot(tt.root)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RNGFromRoot error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			r2, _ := RNGFromRoot(tt.root)
+			for i := 0; i < 100; i++ {
+				if a, b := r1.Int63(), r2.Int63(); a != b {
+					t.Fatalf("draw %d differs for the same root: %d != %d", i, a, b)
+				}
+			}
+		})
+	}
+}
+
+func TestRNGFromRootDiffersByRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "last byte differs", a: "0001", b: "0002"},
+		{name: "length differs", a: "00", b: "0000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ra, _ := RNGFromRoot(tt.a)
+			rb, _ := RNGFromRoot(tt.b)
+			if ra.Int63() == rb.Int63() {
+				t.Errorf("roots %s and %s produced the same first draw", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestIsContiguousRange(t *testing.T) {
+	names := func(ns ...string) []Asset {
+		assets := make([]Asset, len(ns))
+		for i, n := range ns {
+			assets[i] = Asset{PolicyID: testPolicy, AssetName: n}
+		}
+		return assets
+	}
+	tests := []struct {
+		name        string
+		assets      []Asset
+		wantMissing []uint64
+		wantOK      bool
+	}{
+		{name: "complete range", assets: names("SpaceBud2", "SpaceBud0", "SpaceBud1"), wantOK: true},
+		{name: "missing number", assets: names("SpaceBud0", "SpaceBud1", "SpaceBud3"), wantMissing: []uint64{2}},
+		{name: "starts at 0", assets: names("SpaceBud1", "SpaceBud2"), wantMissing: []uint64{0}},
+		{name: "truncated gaps", assets: names("SpaceBud0", "SpaceBud99999999999"), wantMissing: []uint64{1, 2}},
+		{name: "no matches", assets: names("Other0")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missing, ok := IsContiguousRange(tt.assets, "SpaceBud")
+			if ok != tt.wantOK || !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("IsContiguousRange() = (%v, %v), want (%v, %v)", missing, ok, tt.wantMissing, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIsContiguousRangeFrom(t *testing.T) {
+	names := func(ns ...string) []Asset {
+		assets := make([]Asset, len(ns))
+		for i, n := range ns {
+			assets[i] = Asset{PolicyID: testPolicy, AssetName: n}
+		}
+		return assets
+	}
+	tests := []struct {
+		name        string
+		assets      []Asset
+		start       uint64
+		wantMissing []uint64
+		wantOK      bool
+		wantErr     error
+	}{
+		{
+			name:   "complete range",
+			assets: names("SpaceBud2", "SpaceBud0", "SpaceBud1", "SpaceBud3"),
+			wantOK: true,
+		},
+		{
+			name:        "missing number",
+			assets:      names("SpaceBud0", "SpaceBud1", "SpaceBud3", "SpaceBud5"),
+			wantMissing: []uint64{2, 4},
+		},
+		{
+			name:        "missing numbers below the lowest found",
+			assets:      names("SpaceBud2", "SpaceBud3"),
+			wantMissing: []uint64{0, 1},
+		},
+		{
+			name:   "start at 1",
+			assets: names("SpaceBud1", "SpaceBud2", "SpaceBud3"),
+			start:  1,
+			wantOK: true,
+		},
+		{
+			name:   "numbers below start ignored",
+			assets: names("SpaceBud0", "SpaceBud1", "SpaceBud2"),
+			start:  1,
+			wantOK: true,
+		},
+		{
+			name:   "duplicates ignored",
+			assets: names("SpaceBud0", "SpaceBud1", "SpaceBud1", "SpaceBud2"),
+			wantOK: true,
+		},
+		{
+			name:        "leading zeros ignored",
+			assets:      names("SpaceBud0", "SpaceBud01", "SpaceBud2"),
+			wantMissing: []uint64{1},
+		},
+		{
+			name:   "other names ignored",
+			assets: names("SpaceBud0", "SpaceBud1", "Other2", "SpaceBudX", "SpaceBud", "SpaceBud-3"),
+			wantOK: true,
+		},
+		{
+			name:        "gap larger than input",
+			assets:      names("SpaceBud0", "SpaceBud99999999999"),
+			wantMissing: []uint64{1, 2},
+			wantErr:     ErrGapsTruncated,
+		},
+		{
+			name:        "gap equal to input",
+			assets:      names("SpaceBud0", "SpaceBud3"),
+			wantMissing: []uint64{1, 2},
+		},
+		{
+			name:        "duplicated max uint64",
+			assets:      names("SpaceBud18446744073709551615", "SpaceBud18446744073709551615"),
+			start:       18446744073709551614,
+			wantMissing: []uint64{18446744073709551614},
+		},
+		{
+			name:        "full uint64 span",
+			assets:      names("SpaceBud0", "SpaceBud18446744073709551615"),
+			wantMissing: []uint64{1, 2},
+			wantErr:     ErrGapsTruncated,
+		},
+		{
+			name:   "max uint64 alone",
+			assets: names("SpaceBud18446744073709551615"),
+			start:  18446744073709551615,
+			wantOK: true,
+		},
+		{name: "no matches", assets: names("Other0")},
+		{name: "empty", assets: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missing, ok, err := IsContiguousRangeFrom(tt.assets, "SpaceBud", tt.start)
+			if ok != tt.wantOK || !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("IsContiguousRangeFrom() = (%v, %v), want (%v, %v)", missing, ok, tt.wantMissing, tt.wantOK)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("IsContiguousRangeFrom() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFingerprintSetDigest(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	bud2 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud2"}
+	base, err := FingerprintSetDigest([]Asset{bud0, bud1})
+	if err != nil {
+		t.Fatalf("FingerprintSetDigest error = %v", err)
+	}
+	if !strings.HasPrefix(base, "fpset1") {
+		t.Fatalf("FingerprintSetDigest = %q, want fpset1 prefix", base)
+	}
+	tests := []struct {
+		name    string
+		assets  []Asset
+		same    bool
+		wantErr error
+	}{
+		{name: "same order", assets: []Asset{bud0, bud1}, same: true},
+		{name: "reversed order", assets: []Asset{bud1, bud0}, same: true},
+		{name: "duplicate entry", assets: []Asset{bud0, bud1, bud0}, same: true},
+		{name: "asset added", assets: []Asset{bud0, bud1, bud2}},
+		{name: "asset removed", assets: []Asset{bud0}},
+		{name: "asset replaced", assets: []Asset{bud0, bud2}},
+		{name: "empty set", assets: nil},
+		{name: "invalid asset", assets: []Asset{bud0, {PolicyID: "abcd"}}, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FingerprintSetDigest(tt.assets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintSetDigest error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if (got == base) != tt.same {
+				t.Errorf("FingerprintSetDigest = %q, base %q, want same=%v", got, base, tt.same)
+			}
+		})
+	}
+}
+
+func TestCoveringPolicies(t *testing.T) {
+	const (
+		policyA = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+		policyB = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+		policyC = testPolicy
+	)
+	tests := []struct {
+		name   string
+		assets []Asset
+		want   []string
+	}{
+		{
+			name: "count descending",
+			assets: []Asset{
+				{PolicyID: policyA, AssetName: "a"},
+				{PolicyID: policyC, AssetName: "a"},
+				{PolicyID: policyC, AssetName: "b"},
+				{PolicyID: policyC, AssetName: "c"},
+				{PolicyID: policyB, AssetName: "a"},
+				{PolicyID: policyB, AssetName: "b"},
+			},
+			want: []string{policyC, policyB, policyA},
+		},
+		{
+			name: "ties broken by policy ID",
+			assets: []Asset{
+				{PolicyID: policyC, AssetName: "a"},
+				{PolicyID: policyB, AssetName: "a"},
+				{PolicyID: policyA, AssetName: "a"},
+				{PolicyID: policyB, AssetName: "b"},
+				{PolicyID: policyC, AssetName: "b"},
+			},
+			want: []string{policyB, policyC, policyA},
+		},
+		{name: "empty", assets: nil, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CoveringPolicies(tt.assets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoveringPolicies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupAssets(t *testing.T) {
+	raw, err := NewAsset(testPolicy, "SpaceBud0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromHex, err := NewAssetFromHex(testPolicy, "537061636542756430")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	other := Asset{PolicyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", AssetName: "SpaceBud0"}
+	tests := []struct {
+		name   string
+		assets []Asset
+		want   []Asset
+	}{
+		{name: "raw and hex collapse", assets: []Asset{raw, fromHex}, want: []Asset{raw}},
+		{name: "first seen order kept", assets: []Asset{bud1, fromHex, bud1, raw}, want: []Asset{bud1, raw}},
+		{name: "same name other policy kept", assets: []Asset{raw, other}, want: []Asset{raw, other}},
+		{name: "empty", assets: nil, want: []Asset{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DedupAssets(tt.assets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DedupAssets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeltaEncode(t *testing.T) {
+	a := Asset{PolicyID: testPolicy, AssetName: "A"}
+	b := Asset{PolicyID: testPolicy, AssetName: "B"}
+	c := Asset{PolicyID: testPolicy, AssetName: "C"}
+	d := Asset{PolicyID: testPolicy, AssetName: "DD"}
+	tests := []struct {
+		name        string
+		base        []Asset
+		updated     []Asset
+		wantAdded   []Asset
+		wantRemoved []Asset
+	}{
+		{name: "unchanged", base: []Asset{a, b, c}, updated: []Asset{a, b, c}},
+		{name: "additions", base: []Asset{a, c}, updated: []Asset{a, b, c, d}, wantAdded: []Asset{b, d}},
+		{name: "removals", base: []Asset{a, b, c, d}, updated: []Asset{b, c}, wantRemoved: []Asset{a, d}},
+		{name: "both", base: []Asset{a, c}, updated: []Asset{b, c, d}, wantAdded: []Asset{b, d}, wantRemoved: []Asset{a}},
+		{name: "from empty", base: nil, updated: []Asset{a, b}, wantAdded: []Asset{a, b}},
+		{name: "to empty", base: []Asset{a, b}, updated: nil, wantRemoved: []Asset{a, b}},
+		{name: "both empty", base: nil, updated: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := DeltaEncode(tt.base, tt.updated)
+			if len(added) != 0 || len(tt.wantAdded) != 0 {
+				if !reflect.DeepEqual(added, tt.wantAdded) {
+					t.Errorf("added = %v, want %v", added, tt.wantAdded)
+				}
+			}
+			if len(removed) != 0 || len(tt.wantRemoved) != 0 {
+				if !reflect.DeepEqual(removed, tt.wantRemoved) {
+					t.Errorf("removed = %v, want %v", removed, tt.wantRemoved)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSeries(t *testing.T) {
+	const longPrefix = "ABCDEFGHIJKLMNOPQRSTUVWX" // 24 bytes, leaving room for 8 digits
+	tests := []struct {
+		name      string
+		policyID  string
+		prefix    string
+		start     int
+		count     int
+		wantNames []string
+		wantErr   error
+		wantIndex string
+	}{
+		{name: "normal range", policyID: testPolicy, prefix: "SpaceBud", start: 0, count: 3, wantNames: []string{"SpaceBud0", "SpaceBud1", "SpaceBud2"}},
+		{name: "offset start", policyID: testPolicy, prefix: "Bud", start: 9, count: 2, wantNames: []string{"Bud9", "Bud10"}},
+		{name: "empty", policyID: testPolicy, prefix: "SpaceBud", start: 5, count: 0, wantNames: []string{}},
+		{name: "fits at 32 bytes", policyID: testPolicy, prefix: longPrefix, start: 99999999, count: 1, wantNames: []string{longPrefix + "99999999"}},
+		{name: "overflow at high index", policyID: testPolicy, prefix: longPrefix, start: 99999998, count: 3, wantErr: ErrAssetNameTooLong, wantIndex: "index 100000000"},
+		{name: "negative start", policyID: testPolicy, prefix: "SpaceBud", start: -1, count: 1, wantErr: ErrInvalidSeriesRange},
+		{name: "negative count", policyID: testPolicy, prefix: "SpaceBud", start: 0, count: -1, wantErr: ErrInvalidSeriesRange},
+		{name: "count above cap", policyID: testPolicy, prefix: "SpaceBud", start: 0, count: MaxSeriesCount + 1, wantErr: ErrInvalidSeriesRange},
+		{name: "huge count", policyID: testPolicy, prefix: "SpaceBud", start: 0, count: math.MaxInt, wantErr: ErrInvalidSeriesRange},
+		{name: "range overflows int", policyID: testPolicy, prefix: "", start: math.MaxInt - 1, count: 2, wantErr: ErrInvalidSeriesRange},
+		{name: "range ends below max int", policyID: testPolicy, prefix: "", start: math.MaxInt - 1, count: 1, wantNames: []string{strconv.Itoa(math.MaxInt - 1)}},
+		{name: "invalid policy", policyID: "abcd", prefix: "SpaceBud", count: 1, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets, err := GenerateSeries(tt.policyID, tt.prefix, tt.start, tt.count)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GenerateSeries error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if tt.wantIndex != "" && !strings.Contains(err.Error(), tt.wantIndex) {
+					t.Errorf("GenerateSeries error = %q, want it to name %q", err, tt.wantIndex)
+				}
+				return
+			}
+			names := make([]string, len(assets))
+			for i, a := range assets {
+				if a.PolicyID != tt.policyID {
+					t.Errorf("asset %d policy = %s, want %s", i, a.PolicyID, tt.policyID)
+				}
+				names[i] = a.AssetName
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("GenerateSeries names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestSameName(t *testing.T) {
+	const migratedPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	tests := []struct {
+		name string
+		a, b Asset
+		want bool
+	}{
+		{
+			name: "same name different policy",
+			a:    Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+			b:    Asset{PolicyID: migratedPolicy, AssetName: "SpaceBud0"},
+			want: true,
+		},
+		{
+			name: "different name same policy",
+			a:    Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+			b:    Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"},
+			want: false,
+		},
+		{
+			name: "both empty names",
+			a:    Asset{PolicyID: testPolicy},
+			b:    Asset{PolicyID: migratedPolicy},
+			want: true,
+		},
+		{
+			name: "binary names",
+			a:    Asset{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40Bud"},
+			b:    Asset{PolicyID: migratedPolicy, AssetName: "\x00\x06\x43\xb0Bud"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameName(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameName() = %v, want %v", got, tt.want)
+			}
+			if tt.a.PolicyID != tt.b.PolicyID && tt.a == tt.b {
+				t.Error("assets under different policies compare equal")
+			}
+		})
+	}
+}
+
+func TestGroupByName(t *testing.T) {
+	const migratedPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	oldBud := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	newBud := Asset{PolicyID: migratedPolicy, AssetName: "SpaceBud0"}
+	other := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	binary := Asset{PolicyID: migratedPolicy, AssetName: "\x00\x0d\xe1\x40"}
+
+	tests := []struct {
+		name   string
+		assets []Asset
+		want   map[string][]Asset
+	}{
+		{
+			name:   "same name under two policies",
+			assets: []Asset{oldBud, other, newBud},
+			want: map[string][]Asset{
+				"537061636542756430": {oldBud, newBud},
+				"537061636542756431": {other},
+			},
+		},
+		{
+			name:   "binary name keyed by hex",
+			assets: []Asset{binary},
+			want:   map[string][]Asset{"000de140": {binary}},
+		},
+		{name: "empty", assets: nil, want: map[string][]Asset{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GroupByName(tt.assets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAssets(t *testing.T) {
+	valid := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	badPolicy := Asset{PolicyID: "abcd", AssetName: "SpaceBud0"}
+	longName := Asset{PolicyID: testPolicy, AssetName: strings.Repeat("x", MaxAssetNameLength+1)}
+	tests := []struct {
+		name           string
+		assets         []Asset
+		wantErrs       []error
+		wantAnyInvalid bool
+	}{
+		{
+			name:           "mixed",
+			assets:         []Asset{valid, badPolicy, valid, longName},
+			wantErrs:       []error{nil, ErrInvalidPolicyID, nil, ErrAssetNameTooLong},
+			wantAnyInvalid: true,
+		},
+		{name: "all valid", assets: []Asset{valid, {PolicyID: testPolicy}}, wantErrs: []error{nil, nil}},
+		{name: "empty", assets: nil, wantErrs: []error{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, anyInvalid := ValidateAssets(tt.assets)
+			if anyInvalid != tt.wantAnyInvalid {
+				t.Errorf("ValidateAssets() anyInvalid = %v, want %v", anyInvalid, tt.wantAnyInvalid)
+			}
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("len(errs) = %d, want %d", len(errs), len(tt.wantErrs))
+			}
+			for i, want := range tt.wantErrs {
+				if want == nil && errs[i] != nil || !errors.Is(errs[i], want) {
+					t.Errorf("errs[%d] = %v, want %v", i, errs[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	units := []string{
+		testPolicy + "537061636542756430",
+		"notaunit",
+		testPolicy,
+		testPolicy + "zz",
+		testPolicy + "537",
+	}
+	wantAssets := []Asset{
+		{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+		{},
+		{PolicyID: testPolicy},
+		{},
+		{},
+	}
+	wantErrs := []error{nil, ErrInvalidUnit, nil, ErrInvalidHex, ErrInvalidHex}
+
+	assets, errs := ParseUnits(units)
+	if len(assets) != len(units) || len(errs) != len(units) {
+		t.Fatalf("ParseUnits() returned %d assets and %d errors, want %d of each", len(assets), len(errs), len(units))
+	}
+	for i := range units {
+		t.Run(units[i], func(t *testing.T) {
+			if assets[i] != wantAssets[i] {
+				t.Errorf("assets[%d] = %+v, want %+v", i, assets[i], wantAssets[i])
+			}
+			if wantErrs[i] == nil && errs[i] != nil || !errors.Is(errs[i], wantErrs[i]) {
+				t.Errorf("errs[%d] = %v, want %v", i, errs[i], wantErrs[i])
+			}
+		})
+	}
+
+	t.Run("empty input", func(t *testing.T) {
+		assets, errs := ParseUnits(nil)
+		if len(assets) != 0 || len(errs) != 0 {
+			t.Errorf("ParseUnits(nil) = (%v, %v), want empty", assets, errs)
+		}
+	})
+}
+
+func BenchmarkParseUnits(b *testing.B) {
+	units := make([]string, 1000)
+	for i := range units {
+		a := Asset{PolicyID: testPolicy, AssetName: "SpaceBud" + strconv.Itoa(i)}
+		units[i] = a.Unit()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, errs := ParseUnits(units)
+		if errs[0] != nil {
+			b.Fatal(errs[0])
+		}
+	}
+}
+
+func TestSamePolicy(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	tests := []struct {
+		name       string
+		assets     []Asset
+		wantPolicy string
+		wantOK     bool
+	}{
+		{
+			name: "homogeneous",
+			assets: []Asset{
+				{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+				{PolicyID: testPolicy, AssetName: "SpaceBud1"},
+				{PolicyID: testPolicy},
+			},
+			wantPolicy: testPolicy,
+			wantOK:     true,
+		},
+		{name: "single", assets: []Asset{{PolicyID: testPolicy, AssetName: "SpaceBud0"}}, wantPolicy: testPolicy, wantOK: true},
+		{
+			name: "heterogeneous",
+			assets: []Asset{
+				{PolicyID: testPolicy, AssetName: "SpaceBud0"},
+				{PolicyID: testPolicy, AssetName: "SpaceBud1"},
+				{PolicyID: otherPolicy, AssetName: "SpaceBud0"},
+			},
+		},
+		{name: "empty", assets: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, ok := SamePolicy(tt.assets)
+			if policy != tt.wantPolicy || ok != tt.wantOK {
+				t.Errorf("SamePolicy() = (%q, %v), want (%q, %v)", policy, ok, tt.wantPolicy, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMapAssets(t *testing.T) {
+	assets := make([]Asset, 0, len(cip14Vectors))
+	want := make([]string, 0, len(cip14Vectors))
+	for _, v := range cip14Vectors {
+		assets = append(assets, mustAssetFromHex(t, v.policyID, v.assetNameHex))
+		want = append(want, v.fingerprint)
+	}
+	got := MapAssets(assets, func(a Asset) string {
+		return MustFingerprint(a.PolicyID, a.AssetName)
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapAssets(fingerprint) = %v, want %v", got, want)
+	}
+
+	units := MapAssets([]Asset{{PolicyID: testPolicy, AssetName: "SpaceBud0"}}, Asset.Unit)
+	if want := []string{testPolicy + "537061636542756430"}; !reflect.DeepEqual(units, want) {
+		t.Errorf("MapAssets(Asset.Unit) = %v, want %v", units, want)
+	}
+
+	if got := MapAssets(nil, Asset.Unit); len(got) != 0 {
+		t.Errorf("MapAssets(nil) = %v, want empty", got)
+	}
+}
+
+func TestFilterAssets(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	binary := Asset{PolicyID: testPolicy, AssetName: "\x00\x0d\xe1\x40\xff"}
+	tests := []struct {
+		name   string
+		assets []Asset
+		pred   func(Asset) bool
+		want   []Asset
+	}{
+		{name: "utf-8 names", assets: []Asset{bud0, binary, bud1}, pred: Asset.IsValidUTF8Name, want: []Asset{bud0, bud1}},
+		{name: "none match", assets: []Asset{binary}, pred: Asset.IsValidUTF8Name, want: nil},
+		{name: "empty input", assets: nil, pred: Asset.IsValidUTF8Name, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := append([]Asset(nil), tt.assets...)
+			got := FilterAssets(tt.assets, tt.pred)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterAssets() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.assets, in) {
+				t.Errorf("FilterAssets() modified input: %v, want %v", tt.assets, in)
+			}
+		})
+	}
+}
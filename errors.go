@@ -0,0 +1,38 @@
+package cardanoasset
+
+import "fmt"
+
+// maxErrorValueLength caps how much of an offending input a ValidationError
+// keeps, so huge or hostile values do not flood logs.
+const maxErrorValueLength = 64
+
+// ValidationError describes an invalid input: which field was wrong, the
+// offending value (truncated to 64 bytes), and the underlying error. It
+// unwraps to the sentinel, so errors.Is(err, ErrInvalidPolicyID) and similar
+// checks keep working.
+type ValidationError struct {
+	// Field is the name of the invalid input, e.g. "policyId" or "assetNameHex".
+	Field string
+	// Value is the offending input, truncated to 64 bytes.
+	Value string
+	// Err is the underlying error, usually one of the package sentinels.
+	Err error
+}
+
+// Error returns the underlying message followed by the field and quoted value.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v (%s=%q)", e.Err, e.Field, e.Value)
+}
+
+// Unwrap returns the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// newValidationError wraps err with the field name and a truncated copy of value.
+func newValidationError(field, value string, err error) *ValidationError {
+	if len(value) > maxErrorValueLength {
+		value = value[:maxErrorValueLength] + "..."
+	}
+	return &ValidationError{Field: field, Value: value, Err: err}
+}
@@ -0,0 +1,97 @@
+package cardanoasset
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	longName := strings.Repeat("x", 100)
+	tests := []struct {
+		name      string
+		call      func() error
+		wantErr   error
+		wantField string
+		wantValue string
+	}{
+		{
+			name:      "NewAsset bad policy",
+			call:      func() error { _, err := NewAsset("nothex", "SpaceBud0"); return err },
+			wantErr:   ErrInvalidPolicyID,
+			wantField: "policyId",
+			wantValue: "nothex",
+		},
+		{
+			name:      "NewAsset name too long",
+			call:      func() error { _, err := NewAsset(testPolicy, longName); return err },
+			wantErr:   ErrAssetNameTooLong,
+			wantField: "assetName",
+			wantValue: longName[:maxErrorValueLength] + "...",
+		},
+		{
+			name:      "NewAssetFromHex bad hex",
+			call:      func() error { _, err := NewAssetFromHex(testPolicy, "zz"); return err },
+			wantErr:   ErrInvalidHex,
+			wantField: "assetNameHex",
+			wantValue: "zz",
+		},
+		{
+			name:      "ParseAssetID missing policy",
+			call:      func() error { _, err := ParseAssetID(".5370"); return err },
+			wantErr:   ErrInvalidAssetID,
+			wantField: "assetId",
+			wantValue: ".5370",
+		},
+		{
+			name:      "ParseAssetID bad policy",
+			call:      func() error { _, err := ParseAssetID("abc.5370"); return err },
+			wantErr:   ErrInvalidPolicyID,
+			wantField: "policyId",
+			wantValue: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("error = %#v, want *ValidationError", err)
+			}
+			if ve.Field != tt.wantField || ve.Value != tt.wantValue {
+				t.Errorf("ValidationError = {Field: %q, Value: %q}, want {Field: %q, Value: %q}",
+					ve.Field, ve.Value, tt.wantField, tt.wantValue)
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, tt.wantField) || !strings.Contains(msg, tt.wantValue) {
+				t.Errorf("Error() = %q, want it to contain field %q and value %q", msg, tt.wantField, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestValidationErrorTruncation(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "short", value: "abc", want: "abc"},
+		{name: "at limit", value: strings.Repeat("a", maxErrorValueLength), want: strings.Repeat("a", maxErrorValueLength)},
+		{name: "over limit", value: strings.Repeat("a", maxErrorValueLength+1), want: strings.Repeat("a", maxErrorValueLength) + "..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ve := newValidationError("policyId", tt.value, ErrInvalidPolicyID)
+			if ve.Value != tt.want {
+				t.Errorf("Value = %q, want %q", ve.Value, tt.want)
+			}
+			if !errors.Is(ve, ErrInvalidPolicyID) {
+				t.Errorf("errors.Is(%v, ErrInvalidPolicyID) = false", ve)
+			}
+		})
+	}
+}
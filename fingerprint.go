@@ -0,0 +1,204 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// fingerprintLength is the length of every CIP-14 fingerprint: the "asset"
+// HRP, the "1" separator, 32 data characters and a 6-character checksum.
+const fingerprintLength = 44
+
+// ErrInvalidFingerprint is returned when a string is not a CIP-14 fingerprint.
+var ErrInvalidFingerprint = errors.New("invalid asset fingerprint")
+
+// ParseFingerprint decodes a CIP-14 fingerprint and returns its 20-byte
+// blake2b-160 hash. Fingerprints are one-way: the policy ID and asset name
+// cannot be recovered from the hash.
+// Returns an error wrapping ErrInvalidFingerprint if fp is not valid bech32,
+// has an HRP other than "asset", or does not carry exactly 20 bytes.
+//
+// Example:
+//
+//	hash, err := cardanoasset.ParseFingerprint("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3")
+func ParseFingerprint(fp string) ([]byte, error) {
+	hrp, data, err := bech32Decode(fp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFingerprint, err)
+	}
+	if hrp != fingerprintHRP {
+		return nil, fmt.Errorf("%w: HRP %q is not %q", ErrInvalidFingerprint, hrp, fingerprintHRP)
+	}
+	if len(data) != fingerprintHashLength {
+		return nil, fmt.Errorf("%w: %d-byte payload, want %d", ErrInvalidFingerprint, len(data), fingerprintHashLength)
+	}
+	return data, nil
+}
+
+// IsFingerprint reports whether s is a valid CIP-14 fingerprint: bech32 with
+// the "asset" HRP, a valid checksum and a 20-byte payload. Strings of the
+// wrong length are rejected before any decoding work.
+//
+// Example:
+//
+//	ok := cardanoasset.IsFingerprint("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3") // true
+func IsFingerprint(s string) bool {
+	if len(s) != fingerprintLength {
+		return false
+	}
+	_, err := ParseFingerprint(s)
+	return err == nil
+}
+
+// ParseAssetOrFingerprint parses s as whichever identifier it is. For an
+// asset ID ("policyId.assetNameHex") or unit ("policyIdassetNameHex") it
+// returns the Asset and an empty fingerprint. For a CIP-14 fingerprint it
+// returns the zero Asset and the fingerprint in lowercase: fingerprints are
+// one-way hashes, so the policy ID and name cannot be recovered and must be
+// looked up in an indexer. Callers branch on which result is set.
+// Returns the ParseAssetID or ParseUnit error if s is neither.
+//
+// Example:
+//
+//	a, fp, err := cardanoasset.ParseAssetOrFingerprint("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3")
+//	if fp != "" {
+//	    // resolve fp via an indexer
+//	}
+func ParseAssetOrFingerprint(s string) (Asset, string, error) {
+	if IsFingerprint(s) {
+		return Asset{}, strings.ToLower(s), nil
+	}
+	var (
+		a   Asset
+		err error
+	)
+	if strings.Contains(s, ".") {
+		a, err = ParseAssetID(s)
+	} else {
+		a, err = ParseUnit(s)
+	}
+	if err != nil {
+		return Asset{}, "", err
+	}
+	return a, "", nil
+}
+
+// ParseFingerprintAmount parses a "fingerprint:amount" pair such as
+// "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3:123", splitting on the last
+// colon. Fingerprints never contain a colon, so the split is unambiguous.
+// The fingerprint is returned in lowercase.
+// Returns an error wrapping ErrInvalidAmount if the colon or amount is
+// missing, otherwise the ParseFingerprint or ParseAmount error.
+//
+// Example:
+//
+//	fp, n, err := cardanoasset.ParseFingerprintAmount("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3:123")
+func ParseFingerprintAmount(s string) (string, uint64, error) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return "", 0, fmt.Errorf("%w: missing \":<amount>\" in %q", ErrInvalidAmount, s)
+	}
+	fp, amountStr := s[:i], s[i+1:]
+	if _, err := ParseFingerprint(fp); err != nil {
+		return "", 0, err
+	}
+	amount, err := ParseAmount(amountStr)
+	if err != nil {
+		return "", 0, err
+	}
+	return strings.ToLower(fp), amount, nil
+}
+
+// FingerprintEqual reports whether two fingerprints encode the same hash. It
+// compares the decoded 20-byte payloads rather than the strings, so an
+// all-uppercase fingerprint equals its lowercase form.
+// Returns an error wrapping ErrInvalidFingerprint if either fails to decode.
+//
+// Example:
+//
+//	ok, err := cardanoasset.FingerprintEqual(
+//	    "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+//	    "ASSET1RJKLCRNSDZQP65WJGRG55SY9723KW09MLGVLC3",
+//	) // true, nil
+func FingerprintEqual(a, b string) (bool, error) {
+	ha, err := ParseFingerprint(a)
+	if err != nil {
+		return false, err
+	}
+	hb, err := ParseFingerprint(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ha, hb), nil
+}
+
+// ShortFingerprint truncates a fingerprint for display, keeping the first
+// head and last tail characters around "...", e.g. "asset1xy...9qpz".
+// Input that is not a valid bech32 string with the "asset" HRP, or that is
+// no longer than head+tail characters, is returned unchanged. Negative head
+// or tail values are treated as zero.
+//
+// Example:
+//
+//	s := cardanoasset.ShortFingerprint("asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3", 8, 4) // "asset1rj...vlc3"
+func ShortFingerprint(fp string, head, tail int) string {
+	head, tail = max(head, 0), max(tail, 0)
+	if len(fp) <= head+tail {
+		return fp
+	}
+	if hrp, _, err := decodeBech32(fp, bech32Const); err != nil || hrp != fingerprintHRP {
+		return fp
+	}
+	return fp[:head] + "..." + fp[len(fp)-tail:]
+}
+
+// FingerprintHasher builds a CIP-14 fingerprint incrementally: the policy ID
+// is fixed at construction and the asset name bytes are supplied through
+// Write, so it can sit at the end of an io.Copy or other streaming pipeline.
+// Asset names are at most 32 bytes, so the name is buffered and hashed by
+// Sum. A FingerprintHasher is not safe for concurrent use.
+type FingerprintHasher struct {
+	policyID string
+	name     []byte
+}
+
+// NewFingerprintHasher returns a FingerprintHasher for assets under policyID.
+// Returns ErrInvalidPolicyID if the policy ID is not 56 lowercase hex characters.
+//
+// Example:
+//
+//	h, err := cardanoasset.NewFingerprintHasher("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+//	io.WriteString(h, "Space")
+//	io.WriteString(h, "Bud0")
+//	fp, err := h.Sum() // same as Fingerprint(policyID, "SpaceBud0")
+func NewFingerprintHasher(policyID string) (*FingerprintHasher, error) {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return nil, err
+	}
+	return &FingerprintHasher{policyID: policyID}, nil
+}
+
+// Write appends p to the asset name. It implements io.Writer.
+// Returns ErrAssetNameTooLong, writing nothing, if the name would exceed
+// 32 bytes across all writes.
+func (h *FingerprintHasher) Write(p []byte) (int, error) {
+	if len(h.name)+len(p) > MaxAssetNameLength {
+		return 0, ErrAssetNameTooLong
+	}
+	h.name = append(h.name, p...)
+	return len(p), nil
+}
+
+// Sum returns the fingerprint of the policy ID and the name written so far.
+// It does not change the hasher state, so more name bytes may be written
+// afterwards.
+func (h *FingerprintHasher) Sum() (string, error) {
+	return fingerprintUnchecked(blake2b160, h.policyID, string(h.name))
+}
+
+// Reset clears the written name, keeping the policy ID.
+func (h *FingerprintHasher) Reset() {
+	h.name = h.name[:0]
+}
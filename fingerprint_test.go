@@ -0,0 +1,253 @@
+package cardanoasset
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestShortFingerprint(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	tests := []struct {
+		name       string
+		fp         string
+		head, tail int
+		want       string
+	}{
+		{name: "normal", fp: fp, head: 8, tail: 4, want: "asset1rj...vlc3"},
+		{name: "tiny head and tail", fp: fp, head: 1, tail: 1, want: "a...3"},
+		{name: "zero head and tail", fp: fp, head: 0, tail: 0, want: "..."},
+		{name: "negative treated as zero", fp: fp, head: -3, tail: 2, want: "...c3"},
+		{name: "head plus tail covers input", fp: fp, head: 40, tail: 4, want: fp},
+		{name: "head plus tail exceeds input", fp: fp, head: 40, tail: 40, want: fp},
+		{name: "not bech32", fp: "not-a-fingerprint-at-all", head: 4, tail: 4, want: "not-a-fingerprint-at-all"},
+		{name: "bad checksum", fp: fp[:len(fp)-1] + "q", head: 4, tail: 4, want: fp[:len(fp)-1] + "q"},
+		{name: "other hrp", fp: "a12uel5l", head: 1, tail: 1, want: "a12uel5l"},
+		{name: "empty", fp: "", head: 4, tail: 4, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortFingerprint(tt.fp, tt.head, tt.tail); got != tt.want {
+				t.Errorf("ShortFingerprint(%q, %d, %d) = %q, want %q", tt.fp, tt.head, tt.tail, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsFingerprint(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	hash, err := ParseFingerprint(fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ConvertBits(hash, 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherHRP, err := Bech32Encode("asxet", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bech32m, err := Bech32mEncode(fingerprintHRP, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{name: "valid", s: fp, want: true},
+		{name: "uppercase", s: strings.ToUpper(fp), want: true},
+		{name: "address", s: "addr1vpu5vlrf4xkxv2qpwngf6cjhtw542ayty80v8dyr49rf5eg0yu80w", want: false},
+		{name: "corrupted checksum", s: fp[:len(fp)-1] + "q", want: false},
+		{name: "corrupted data", s: fp[:10] + "q" + fp[11:], want: false},
+		{name: "other hrp same length", s: otherHRP, want: false},
+		{name: "bech32m checksum", s: bech32m, want: false},
+		{name: "mixed case", s: "A" + fp[1:], want: false},
+		{name: "empty", s: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsFingerprint(tt.s); got != tt.want {
+				t.Errorf("IsFingerprint(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFingerprintHasher(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		chunks []string
+		want   string
+	}{
+		{name: "two chunks", policy: testPolicy, chunks: []string{"Space", "Bud0"}},
+		{name: "single chunk", policy: testPolicy, chunks: []string{"SpaceBud0"}},
+		{name: "byte at a time", policy: testPolicy, chunks: strings.Split("SpaceBud0", "")},
+		{name: "no writes", policy: cip14Vectors[0].policyID, want: cip14Vectors[0].fingerprint},
+		{name: "cip-14 vector", policy: cip14Vectors[3].policyID, chunks: []string{"PAT", "ATE"}, want: cip14Vectors[3].fingerprint},
+		{name: "32 bytes across writes", policy: testPolicy, chunks: []string{strings.Repeat("a", 16), strings.Repeat("b", 16)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewFingerprintHasher(tt.policy)
+			if err != nil {
+				t.Fatalf("NewFingerprintHasher() error = %v", err)
+			}
+			for _, c := range tt.chunks {
+				if _, err := io.WriteString(h, c); err != nil {
+					t.Fatalf("Write(%q) error = %v", c, err)
+				}
+			}
+			got, err := h.Sum()
+			if err != nil {
+				t.Fatalf("Sum() error = %v", err)
+			}
+			want := tt.want
+			if want == "" {
+				if want, err = Fingerprint(tt.policy, strings.Join(tt.chunks, "")); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got != want {
+				t.Errorf("Sum() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFingerprintHasherErrors(t *testing.T) {
+	if _, err := NewFingerprintHasher("abcd"); !errors.Is(err, ErrInvalidPolicyID) {
+		t.Errorf("NewFingerprintHasher(\"abcd\") error = %v, want %v", err, ErrInvalidPolicyID)
+	}
+
+	h, err := NewFingerprintHasher(testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(h, strings.Repeat("a", 20)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := io.WriteString(h, strings.Repeat("b", 13))
+	if !errors.Is(err, ErrAssetNameTooLong) || n != 0 {
+		t.Fatalf("Write past 32 bytes = (%d, %v), want (0, %v)", n, err, ErrAssetNameTooLong)
+	}
+	got, err := h.Sum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Fingerprint(testPolicy, strings.Repeat("a", 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Sum() after rejected write = %s, want %s", got, want)
+	}
+
+	h.Reset()
+	if _, err := io.WriteString(h, "SpaceBud0"); err != nil {
+		t.Fatal(err)
+	}
+	got, err = h.Sum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := Fingerprint(testPolicy, "SpaceBud0"); got != want {
+		t.Errorf("Sum() after Reset = %s, want %s", got, want)
+	}
+}
+
+func TestFingerprintEqual(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	tests := []struct {
+		name    string
+		a, b    string
+		want    bool
+		wantErr error
+	}{
+		{name: "identical", a: fp, b: fp, want: true},
+		{name: "case differs", a: fp, b: strings.ToUpper(fp), want: true},
+		{name: "different assets", a: fp, b: cip14Vectors[1].fingerprint, want: false},
+		{name: "first invalid", a: "asset1notvalid", b: fp, wantErr: ErrInvalidFingerprint},
+		{name: "second bad checksum", a: fp, b: fp[:len(fp)-1] + "q", wantErr: ErrInvalidFingerprint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FingerprintEqual(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FingerprintEqual() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FingerprintEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAssetOrFingerprint(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name    string
+		in      string
+		want    Asset
+		wantFP  string
+		wantErr error
+	}{
+		{name: "fingerprint", in: fp, wantFP: fp},
+		{name: "uppercase fingerprint", in: strings.ToUpper(fp), wantFP: fp},
+		{name: "asset ID", in: testPolicy + ".537061636542756430", want: bud0},
+		{name: "unit", in: testPolicy + "537061636542756430", want: bud0},
+		{name: "bare policy", in: testPolicy, want: Asset{PolicyID: testPolicy}},
+		{name: "corrupted fingerprint", in: fp[:len(fp)-1] + "q", wantErr: ErrInvalidUnit},
+		{name: "invalid asset ID", in: "abc.5370", wantErr: ErrInvalidPolicyID},
+		{name: "empty", in: "", wantErr: ErrInvalidUnit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotFP, err := ParseAssetOrFingerprint(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAssetOrFingerprint(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want || gotFP != tt.wantFP {
+				t.Errorf("ParseAssetOrFingerprint(%q) = (%+v, %q), want (%+v, %q)", tt.in, got, gotFP, tt.want, tt.wantFP)
+			}
+		})
+	}
+}
+
+func TestParseFingerprintAmount(t *testing.T) {
+	const fp = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+	tests := []struct {
+		name       string
+		in         string
+		wantFP     string
+		wantAmount uint64
+		wantErr    error
+	}{
+		{name: "valid pair", in: fp + ":123", wantFP: fp, wantAmount: 123},
+		{name: "uppercase fingerprint", in: strings.ToUpper(fp) + ":1", wantFP: fp, wantAmount: 1},
+		{name: "zero amount", in: fp + ":0", wantFP: fp},
+		{name: "missing amount", in: fp, wantErr: ErrInvalidAmount},
+		{name: "empty amount", in: fp + ":", wantErr: ErrInvalidAmount},
+		{name: "negative amount", in: fp + ":-1", wantErr: ErrAmountNegative},
+		{name: "overflow", in: fp + ":18446744073709551616", wantErr: ErrAmountOverflow},
+		{name: "last colon split", in: fp + ":1:2", wantErr: ErrInvalidFingerprint},
+		{name: "bad fingerprint", in: fp[:len(fp)-1] + "q:1", wantErr: ErrInvalidFingerprint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFP, gotAmount, err := ParseFingerprintAmount(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseFingerprintAmount(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if gotFP != tt.wantFP || gotAmount != tt.wantAmount {
+				t.Errorf("ParseFingerprintAmount(%q) = (%q, %d), want (%q, %d)", tt.in, gotFP, gotAmount, tt.wantFP, tt.wantAmount)
+			}
+		})
+	}
+}
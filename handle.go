@@ -0,0 +1,55 @@
+package cardanoasset
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// ADAHandlePolicyID is the minting policy of ADA Handles on mainnet.
+	ADAHandlePolicyID = "f0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a"
+
+	// MaxADAHandleLength is the maximum number of characters in an ADA Handle.
+	MaxADAHandleLength = 15
+)
+
+// ErrInvalidADAHandle is returned when a handle has an invalid length or characters.
+var ErrInvalidADAHandle = errors.New("invalid ADA Handle: must be 1-15 characters of a-z, 0-9, '-', '_' or '.'")
+
+// ParseADAHandle builds the Asset for an ADA Handle. An optional leading "$"
+// is stripped; the remaining handle must be 1-15 characters drawn from
+// lowercase letters, digits, '-', '_' and '.'. The asset name is the handle
+// text itself under ADAHandlePolicyID.
+// Returns ErrInvalidADAHandle for malformed handles.
+//
+// Example:
+//
+//	a, err := cardanoasset.ParseADAHandle("$alice")
+func ParseADAHandle(handle string) (Asset, error) {
+	name := strings.TrimPrefix(handle, "$")
+	if name == "" || len(name) > MaxADAHandleLength {
+		return Asset{}, ErrInvalidADAHandle
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !((c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.') {
+			return Asset{}, ErrInvalidADAHandle
+		}
+	}
+	return Asset{PolicyID: ADAHandlePolicyID, AssetName: name}, nil
+}
+
+// ADAHandleFingerprint returns the CIP-14 fingerprint of the asset for an
+// ADA Handle, equivalent to ParseADAHandle followed by Asset.Fingerprint.
+// Returns ErrInvalidADAHandle for malformed handles.
+//
+// Example:
+//
+//	fp, err := cardanoasset.ADAHandleFingerprint("$alice")
+func ADAHandleFingerprint(handle string) (string, error) {
+	a, err := ParseADAHandle(handle)
+	if err != nil {
+		return "", err
+	}
+	return a.Fingerprint()
+}
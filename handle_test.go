@@ -0,0 +1,71 @@
+package cardanoasset
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseADAHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		handle  string
+		want    Asset
+		wantErr error
+	}{
+		{name: "with dollar", handle: "$alice", want: Asset{PolicyID: ADAHandlePolicyID, AssetName: "alice"}},
+		{name: "without dollar", handle: "alice", want: Asset{PolicyID: ADAHandlePolicyID, AssetName: "alice"}},
+		{name: "punctuation", handle: "$a-b_c.d", want: Asset{PolicyID: ADAHandlePolicyID, AssetName: "a-b_c.d"}},
+		{name: "max length", handle: strings.Repeat("a", MaxADAHandleLength), want: Asset{PolicyID: ADAHandlePolicyID, AssetName: strings.Repeat("a", MaxADAHandleLength)}},
+		{name: "uppercase", handle: "$Alice", wantErr: ErrInvalidADAHandle},
+		{name: "space", handle: "$al ice", wantErr: ErrInvalidADAHandle},
+		{name: "dollar only", handle: "$", wantErr: ErrInvalidADAHandle},
+		{name: "empty", handle: "", wantErr: ErrInvalidADAHandle},
+		{name: "too long", handle: strings.Repeat("a", MaxADAHandleLength+1), wantErr: ErrInvalidADAHandle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseADAHandle(tt.handle)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseADAHandle(%q) error = %v, want %v", tt.handle, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseADAHandle(%q) = %+v, want %+v", tt.handle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADAHandleFingerprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		handle  string
+		wantErr error
+	}{
+		{name: "with dollar", handle: "$alice"},
+		{name: "without dollar", handle: "alice"},
+		{name: "invalid", handle: "$Alice", wantErr: ErrInvalidADAHandle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ADAHandleFingerprint(tt.handle)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ADAHandleFingerprint(%q) error = %v, want %v", tt.handle, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			a, err := ParseADAHandle(tt.handle)
+			if err != nil {
+				t.Fatalf("ParseADAHandle(%q) error = %v", tt.handle, err)
+			}
+			want, err := a.Fingerprint()
+			if err != nil {
+				t.Fatalf("Fingerprint() error = %v", err)
+			}
+			if got != want {
+				t.Errorf("ADAHandleFingerprint(%q) = %s, want %s", tt.handle, got, want)
+			}
+		})
+	}
+}
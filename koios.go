@@ -0,0 +1,54 @@
+package cardanoasset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrFingerprintMismatch is returned when a provided fingerprint differs from
+// the one computed for the asset.
+var ErrFingerprintMismatch = errors.New("fingerprint mismatch")
+
+// koiosAsset is one entry of a Koios asset list response.
+type koiosAsset struct {
+	PolicyID    string `json:"policy_id"`
+	AssetName   string `json:"asset_name"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// ParseKoiosAssets decodes a Koios asset list, a JSON array of
+// {"policy_id", "asset_name", "fingerprint"} objects with hex-encoded names,
+// into fully populated AssetInfo values. When an entry carries a
+// fingerprint it is cross-checked against the computed one.
+// Returns an error naming the offending entry index that wraps the
+// NewAssetFromHex error or ErrFingerprintMismatch.
+//
+// Example:
+//
+//	resp, _ := http.Get("https://api.koios.rest/api/v1/asset_list")
+//	defer resp.Body.Close()
+//	infos, err := cardanoasset.ParseKoiosAssets(resp.Body)
+func ParseKoiosAssets(r io.Reader) ([]AssetInfo, error) {
+	var entries []koiosAsset
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decoding Koios asset list: %w", err)
+	}
+	infos := make([]AssetInfo, 0, len(entries))
+	for i, e := range entries {
+		a, err := NewAssetFromHex(e.PolicyID, e.AssetName)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		info, err := a.Info()
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		if e.Fingerprint != "" && e.Fingerprint != info.Fingerprint {
+			return nil, fmt.Errorf("entry %d: %w: got %s, computed %s", i, ErrFingerprintMismatch, e.Fingerprint, info.Fingerprint)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
@@ -0,0 +1,74 @@
+package cardanoasset
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseKoiosAssets(t *testing.T) {
+	f, err := os.Open("testdata/koios_assets.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	infos, err := ParseKoiosAssets(f)
+	if err != nil {
+		t.Fatalf("ParseKoiosAssets error = %v", err)
+	}
+	want := []struct {
+		policyID, nameHex, fingerprint string
+	}{
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "", "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"},
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "504154415445", "asset13n25uv0yaf5kus35fm2k86cqy60z58d9xmde92"},
+		{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "504154415445", "asset1hv4p5tv2a837mzqrst04d0dcptdjmluqvdx9k3"},
+	}
+	if len(infos) != len(want) {
+		t.Fatalf("len(infos) = %d, want %d", len(infos), len(want))
+	}
+	for i, w := range want {
+		got := infos[i]
+		if got.PolicyID != w.policyID || got.AssetNameHex != w.nameHex || got.Fingerprint != w.fingerprint {
+			t.Errorf("infos[%d] = %s/%s/%s, want %s/%s/%s", i,
+				got.PolicyID, got.AssetNameHex, got.Fingerprint, w.policyID, w.nameHex, w.fingerprint)
+		}
+	}
+}
+
+func TestParseKoiosAssetsErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		input     string
+		wantErr   error
+		wantEntry string
+	}{
+		{name: "mismatched fingerprint", file: "testdata/koios_assets_mismatch.json", wantErr: ErrFingerprintMismatch, wantEntry: "entry 1"},
+		{name: "invalid policy", input: `[{"policy_id": "abcd", "asset_name": ""}]`, wantErr: ErrInvalidPolicyID, wantEntry: "entry 0"},
+		{name: "invalid name hex", input: `[{"policy_id": "` + testPolicy + `", "asset_name": "zz"}]`, wantErr: ErrInvalidHex, wantEntry: "entry 0"},
+		{name: "not an array", input: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			if tt.file != "" {
+				b, err := os.ReadFile(tt.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				input = string(b)
+			}
+			_, err := ParseKoiosAssets(strings.NewReader(input))
+			if err == nil {
+				t.Fatal("ParseKoiosAssets error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseKoiosAssets error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantEntry) {
+				t.Errorf("ParseKoiosAssets error = %q, want it to name %q", err, tt.wantEntry)
+			}
+		})
+	}
+}
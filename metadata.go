@@ -0,0 +1,129 @@
+package cardanoasset
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// traitContainerKeys are the metadata keys under which CIP-25 collections
+// commonly nest their traits, in lookup order.
+var traitContainerKeys = []string{"attributes", "traits"}
+
+// editionKeys are the metadata keys commonly holding an edition or serial
+// number, in lookup order.
+var editionKeys = []string{"edition", "serial", "number"}
+
+// ExtractTraits flattens the traits of a single asset's CIP-25 metadata into
+// a trait-name → trait-value map suitable for rarity scoring. Traits are read
+// from a nested "attributes" or "traits" entry, which may be an object
+// ({"Background": "Blue"}) or a list of {"trait_type": ..., "value": ...}
+// objects. Only string values are kept. Returns an empty map when neither
+// entry is present.
+//
+// Example:
+//
+//	traits := cardanoasset.ExtractTraits(map[string]interface{}{
+//	    "name":       "SpaceBud #0",
+//	    "attributes": map[string]interface{}{"Background": "Blue"},
+//	}) // map[Background:Blue]
+func ExtractTraits(metadata map[string]interface{}) map[string]string {
+	traits := make(map[string]string)
+	for _, key := range traitContainerKeys {
+		switch container := metadata[key].(type) {
+		case map[string]interface{}:
+			for name, value := range container {
+				if s, ok := value.(string); ok {
+					traits[name] = s
+				}
+			}
+			return traits
+		case []interface{}:
+			for _, item := range container {
+				entry, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, nameOK := entry["trait_type"].(string)
+				value, valueOK := entry["value"].(string)
+				if nameOK && valueOK {
+					traits[name] = value
+				}
+			}
+			return traits
+		}
+	}
+	return traits
+}
+
+// RarityScore computes the rarity.tools score of one asset: the sum over its
+// traits of total / count, where count is how many of the total assets in the
+// collection share that trait value. traitFreq maps trait name → value →
+// count, for example as accumulated from ExtractTraits over the collection.
+// Traits with no recorded frequency contribute nothing; a non-positive total
+// yields 0. Higher scores are rarer.
+//
+// Example:
+//
+//	score := cardanoasset.RarityScore(
+//	    map[string]string{"Background": "Gold"},
+//	    map[string]map[string]int{"Background": {"Gold": 1, "Blue": 9}},
+//	    10,
+//	) // 10
+func RarityScore(assetTraits map[string]string, traitFreq map[string]map[string]int, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	var score float64
+	for name, value := range assetTraits {
+		count := traitFreq[name][value]
+		if count <= 0 {
+			continue
+		}
+		score += float64(total) / float64(count)
+	}
+	return score
+}
+
+// ExtractEdition returns the edition number of an editioned NFT from its
+// CIP-25 metadata, looking at the "edition", "serial" and "number" keys in
+// that order. Values may be JSON numbers (float64 or json.Number), Go integer
+// types, or decimal strings; they must be non-negative whole numbers. It
+// reports false when no key holds a usable value.
+//
+// Example:
+//
+//	n, ok := cardanoasset.ExtractEdition(map[string]interface{}{"edition": "42"}) // 42, true
+func ExtractEdition(metadata map[string]interface{}) (uint64, bool) {
+	for _, key := range editionKeys {
+		if n, ok := toEdition(metadata[key]); ok {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// toEdition converts a metadata value to a non-negative whole number.
+func toEdition(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case float64:
+		if n < 0 || n >= math.MaxUint64 || n != math.Trunc(n) {
+			return 0, false
+		}
+		return uint64(n), true
+	case int:
+		return uint64(n), n >= 0
+	case int64:
+		return uint64(n), n >= 0
+	case uint64:
+		return n, true
+	case json.Number:
+		return toEdition(string(n))
+	case string:
+		u, err := strconv.ParseUint(strings.TrimSpace(n), 10, 64)
+		return u, err == nil
+	default:
+		return 0, false
+	}
+}
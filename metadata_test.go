@@ -0,0 +1,154 @@
+package cardanoasset
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestExtractTraits(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		want     map[string]string
+	}{
+		{
+			name: "cip-25 attributes object",
+			metadata: map[string]interface{}{
+				"name":  "SpaceBud #0",
+				"image": "ipfs://QmXxx",
+				"attributes": map[string]interface{}{
+					"Background": "Blue",
+					"Helmet":     "Gold",
+					"Level":      3.0,
+				},
+			},
+			want: map[string]string{"Background": "Blue", "Helmet": "Gold"},
+		},
+		{
+			name: "traits list",
+			metadata: map[string]interface{}{
+				"traits": []interface{}{
+					map[string]interface{}{"trait_type": "Background", "value": "Red"},
+					map[string]interface{}{"trait_type": "Eyes", "value": 2.0},
+					"not an object",
+				},
+			},
+			want: map[string]string{"Background": "Red"},
+		},
+		{
+			name: "attributes preferred over traits",
+			metadata: map[string]interface{}{
+				"attributes": map[string]interface{}{"Background": "Blue"},
+				"traits":     map[string]interface{}{"Background": "Red"},
+			},
+			want: map[string]string{"Background": "Blue"},
+		},
+		{
+			name:     "missing attributes",
+			metadata: map[string]interface{}{"name": "SpaceBud #0"},
+			want:     map[string]string{},
+		},
+		{name: "nil metadata", metadata: nil, want: map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractTraits(tt.metadata); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractTraits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRarityScore(t *testing.T) {
+	// A synthetic 10-asset collection: one Gold background, nine Blue; two
+	// Laser eyes, eight Normal.
+	freq := map[string]map[string]int{
+		"Background": {"Gold": 1, "Blue": 9},
+		"Eyes":       {"Laser": 2, "Normal": 8},
+	}
+	const total = 10
+	tests := []struct {
+		name   string
+		traits map[string]string
+		want   float64
+	}{
+		{name: "gold laser", traits: map[string]string{"Background": "Gold", "Eyes": "Laser"}, want: 10 + 5},
+		{name: "gold normal", traits: map[string]string{"Background": "Gold", "Eyes": "Normal"}, want: 10 + 1.25},
+		{name: "blue laser", traits: map[string]string{"Background": "Blue", "Eyes": "Laser"}, want: 10.0/9 + 5},
+		{name: "blue normal", traits: map[string]string{"Background": "Blue", "Eyes": "Normal"}, want: 10.0/9 + 1.25},
+		{name: "unknown trait ignored", traits: map[string]string{"Hat": "Crown", "Background": "Gold"}, want: 10},
+		{name: "no traits", traits: nil, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RarityScore(tt.traits, freq, total)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RarityScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRarityScoreOrdering(t *testing.T) {
+	freq := map[string]map[string]int{
+		"Background": {"Gold": 1, "Blue": 9},
+		"Eyes":       {"Laser": 2, "Normal": 8},
+	}
+	rarestFirst := []map[string]string{
+		{"Background": "Gold", "Eyes": "Laser"},
+		{"Background": "Gold", "Eyes": "Normal"},
+		{"Background": "Blue", "Eyes": "Laser"},
+		{"Background": "Blue", "Eyes": "Normal"},
+	}
+	for i := 1; i < len(rarestFirst); i++ {
+		hi := RarityScore(rarestFirst[i-1], freq, 10)
+		lo := RarityScore(rarestFirst[i], freq, 10)
+		if hi <= lo {
+			t.Errorf("RarityScore(%v) = %v, want more than RarityScore(%v) = %v", rarestFirst[i-1], hi, rarestFirst[i], lo)
+		}
+	}
+}
+
+func TestRarityScoreNonPositiveTotal(t *testing.T) {
+	freq := map[string]map[string]int{"Background": {"Gold": 1}}
+	for _, total := range []int{0, -1} {
+		if got := RarityScore(map[string]string{"Background": "Gold"}, freq, total); got != 0 {
+			t.Errorf("RarityScore(total=%d) = %v, want 0", total, got)
+		}
+	}
+}
+
+func TestExtractEdition(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		want     uint64
+		wantOK   bool
+	}{
+		{name: "edition number", metadata: map[string]interface{}{"edition": 42.0}, want: 42, wantOK: true},
+		{name: "edition string", metadata: map[string]interface{}{"edition": "42"}, want: 42, wantOK: true},
+		{name: "serial json.Number", metadata: map[string]interface{}{"serial": json.Number("7")}, want: 7, wantOK: true},
+		{name: "number int", metadata: map[string]interface{}{"number": 3}, want: 3, wantOK: true},
+		{name: "uint64", metadata: map[string]interface{}{"edition": uint64(9)}, want: 9, wantOK: true},
+		{name: "int64", metadata: map[string]interface{}{"edition": int64(9)}, want: 9, wantOK: true},
+		{name: "padded string", metadata: map[string]interface{}{"edition": " 5 "}, want: 5, wantOK: true},
+		{name: "edition before serial", metadata: map[string]interface{}{"serial": 2.0, "edition": 1.0}, want: 1, wantOK: true},
+		{name: "unusable edition falls through", metadata: map[string]interface{}{"edition": "first", "serial": 2.0}, want: 2, wantOK: true},
+		{name: "fractional", metadata: map[string]interface{}{"edition": 1.5}},
+		{name: "negative", metadata: map[string]interface{}{"edition": -1.0}},
+		{name: "negative int", metadata: map[string]interface{}{"edition": -1}},
+		{name: "bool", metadata: map[string]interface{}{"edition": true}},
+		{name: "missing edition", metadata: map[string]interface{}{"name": "SpaceBud #0"}},
+		{name: "nil metadata", metadata: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ExtractEdition(tt.metadata)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ExtractEdition() = (%d, %v), want (%d, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
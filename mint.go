@@ -0,0 +1,76 @@
+package cardanoasset
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateMintEntry is returned when a mint set lists the same asset
+// more than once, which the ledger rejects.
+var ErrDuplicateMintEntry = errors.New("duplicate mint entry")
+
+// MintAmount is one entry of a transaction's mint field: a positive Amount
+// mints tokens of Asset and a negative Amount burns them.
+type MintAmount struct {
+	// Asset is the native token being minted or burned.
+	Asset Asset
+	// Amount is the signed quantity: positive to mint, negative to burn.
+	Amount int64
+}
+
+// MintValue lists the mints and burns of a transaction. Unlike Value, whose
+// quantities are unsigned, it can describe burns. Each asset may appear at
+// most once; see Validate.
+type MintValue []MintAmount
+
+// Validate checks every entry's asset and that no asset appears twice.
+// Returns the asset's validation error, or an error wrapping
+// ErrDuplicateMintEntry that names the asset and both entry indexes.
+//
+// Example:
+//
+//	if err := mint.Validate(); err != nil {
+//	    return err
+//	}
+func (m MintValue) Validate() error {
+	seen := make(map[Asset]int, len(m))
+	for i, e := range m {
+		if err := e.Asset.Validate(); err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+		if j, dup := seen[e.Asset]; dup {
+			return fmt.Errorf("%w: %s at entries %d and %d", ErrDuplicateMintEntry, e.Asset.AssetID(), j, i)
+		}
+		seen[e.Asset] = i
+	}
+	return nil
+}
+
+// CBOR encodes m as the transaction body mint field: the multi-asset map
+// {policy_id => {asset_name => int64}} with canonical key ordering, burns
+// encoded as negative integers. Zero-amount entries are skipped.
+// Returns the Validate error if an entry is malformed or duplicated; Lovelace
+// fails validation because ADA cannot be minted.
+//
+// Example:
+//
+//	mint := cardanoasset.MintValue{{Asset: newToken, Amount: 1}, {Asset: oldToken, Amount: -1}}
+//	field, err := mint.CBOR()
+func (m MintValue) CBOR() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	amounts := make(map[Asset]int64, len(m))
+	assets := make([]Asset, 0, len(m))
+	for _, e := range m {
+		if e.Amount == 0 {
+			continue
+		}
+		amounts[e.Asset] = e.Amount
+		assets = append(assets, e.Asset)
+	}
+	SortAssets(assets)
+	return appendMultiAsset(nil, assets, func(buf []byte, a Asset) []byte {
+		return appendCBORInt(buf, amounts[a])
+	})
+}
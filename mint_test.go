@@ -0,0 +1,91 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestMintValueCBOR(t *testing.T) {
+	const otherPolicy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	const bud0Key = "49537061636542756430"
+	const bud1Key = "49537061636542756431"
+	tests := []struct {
+		name    string
+		m       MintValue
+		want    string
+		wantErr error
+	}{
+		{name: "mint one", m: MintValue{{Asset: bud0, Amount: 1}}, want: "a1581c" + testPolicy + "a1" + bud0Key + "01"},
+		{name: "burn one", m: MintValue{{Asset: bud0, Amount: -1}}, want: "a1581c" + testPolicy + "a1" + bud0Key + "20"},
+		{name: "burn 1000", m: MintValue{{Asset: bud0, Amount: -1000}}, want: "a1581c" + testPolicy + "a1" + bud0Key + "3903e7"},
+		{name: "min int64", m: MintValue{{Asset: bud0, Amount: math.MinInt64}}, want: "a1581c" + testPolicy + "a1" + bud0Key + "3b7fffffffffffffff"},
+		{
+			name: "mint and burn in canonical order",
+			m: MintValue{
+				{Asset: bud1, Amount: 1},
+				{Asset: Asset{PolicyID: otherPolicy}, Amount: -5},
+				{Asset: bud0, Amount: -1},
+				{Asset: Asset{PolicyID: otherPolicy, AssetName: "x"}, Amount: 0},
+			},
+			want: "a2" +
+				"581c" + otherPolicy + "a1" + "40" + "24" +
+				"581c" + testPolicy + "a2" + bud0Key + "20" + bud1Key + "01",
+		},
+		{name: "empty", m: nil, want: "a0"},
+		{name: "lovelace cannot be minted", m: MintValue{{Asset: Lovelace, Amount: 1}}, wantErr: ErrInvalidPolicyID},
+		{name: "duplicate", m: MintValue{{Asset: bud0, Amount: 1}, {Asset: bud0, Amount: -1}}, wantErr: ErrDuplicateMintEntry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.CBOR()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CBOR() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && hex.EncodeToString(got) != tt.want {
+				t.Errorf("CBOR() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMintValueValidate(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	tests := []struct {
+		name        string
+		m           MintValue
+		wantErr     error
+		wantErrText string
+	}{
+		{name: "distinct entries", m: MintValue{{Asset: bud0, Amount: 1}, {Asset: bud1, Amount: -1}}},
+		{name: "empty", m: nil},
+		{
+			name:        "duplicated entry",
+			m:           MintValue{{Asset: bud0, Amount: 1}, {Asset: bud1, Amount: 1}, {Asset: bud0, Amount: 2}},
+			wantErr:     ErrDuplicateMintEntry,
+			wantErrText: bud0.AssetID() + " at entries 0 and 2",
+		},
+		{
+			name:        "invalid asset",
+			m:           MintValue{{Asset: bud0, Amount: 1}, {Asset: Asset{PolicyID: "abcd"}, Amount: 1}},
+			wantErr:     ErrInvalidPolicyID,
+			wantErrText: "entry 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.m.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantErrText) {
+				t.Errorf("Validate() error = %q, want it to contain %q", err, tt.wantErrText)
+			}
+		})
+	}
+}
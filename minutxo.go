@@ -0,0 +1,36 @@
+package cardanoasset
+
+// Min-UTxO constants from the Babbage ledger rule
+// minLovelace = (minUTxOEntryOverhead + |serialized output|) * coinsPerUTxOByte.
+const (
+	// minUTxOEntryOverhead is the fixed per-entry overhead in bytes the ledger
+	// adds to the serialized output size.
+	minUTxOEntryOverhead = 160
+	// minUTxOOutputOverhead approximates the bytes an output spends outside
+	// its value: the two-element output array head plus a 57-byte Shelley
+	// base address, with no datum or reference script.
+	minUTxOOutputOverhead = 60
+)
+
+// MinUTxOLovelace estimates the minimum lovelace an output carrying v must
+// hold, given the coinsPerUTxOByte protocol parameter (4310 on mainnet at the
+// time of writing; read it from current protocol parameters). It applies the
+// Babbage formula to the canonical CBOR size of v plus an allowance for a
+// base address with no datum, and accounts for the coin field growing to
+// hold the result. The lovelace already in v does not otherwise matter.
+//
+// This is an estimate: outputs with longer addresses, inline datums or
+// reference scripts need more.
+//
+// Example:
+//
+//	minADA := cardanoasset.MinUTxOLovelace(cardanoasset.Value{}, 4310) // 969750
+func MinUTxOLovelace(v Value, coinsPerUTxOByte uint64) uint64 {
+	size := v.SerializedSize() - cborHeadSize(v[Lovelace])
+	var minLovelace uint64
+	for i := 0; i < 2; i++ {
+		total := uint64(minUTxOEntryOverhead + minUTxOOutputOverhead + size + cborHeadSize(minLovelace))
+		minLovelace = total * coinsPerUTxOByte
+	}
+	return minLovelace
+}
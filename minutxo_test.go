@@ -0,0 +1,51 @@
+package cardanoasset
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMinUTxOLovelace(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	bundle := Value{Lovelace: 5000000}
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("SpaceBud%d", i)
+		bundle[Asset{PolicyID: testPolicy, AssetName: name}] = 1
+		bundle[Asset{PolicyID: otherPolicy, AssetName: name}] = 1
+	}
+	tests := []struct {
+		name             string
+		v                Value
+		coinsPerUTxOByte uint64
+		want             uint64
+	}{
+		// (160 + 60 + 5-byte coin) * 4310
+		{name: "lovelace only", v: Value{}, coinsPerUTxOByte: 4310, want: 969750},
+		{name: "lovelace amount ignored", v: Value{Lovelace: 10}, coinsPerUTxOByte: 4310, want: 969750},
+		// 44 value bytes besides the coin: (225 + 44) * 4310
+		{name: "one asset", v: Value{{PolicyID: testPolicy, AssetName: "SpaceBud0"}: 1}, coinsPerUTxOByte: 4310, want: 1159390},
+		// 284 value bytes besides the coin: (225 + 284) * 4310
+		{name: "two policies of ten assets", v: bundle, coinsPerUTxOByte: 4310, want: 2193790},
+		{name: "zero parameter", v: bundle, coinsPerUTxOByte: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinUTxOLovelace(tt.v, tt.coinsPerUTxOByte); got != tt.want {
+				t.Errorf("MinUTxOLovelace() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinUTxOLovelaceGrowsWithBundle(t *testing.T) {
+	v := Value{}
+	prev := MinUTxOLovelace(v, 4310)
+	for i := 0; i < 5; i++ {
+		v[Asset{PolicyID: testPolicy, AssetName: fmt.Sprintf("SpaceBud%d", i)}] = 1
+		got := MinUTxOLovelace(v, 4310)
+		if got <= prev {
+			t.Fatalf("MinUTxOLovelace() with %d assets = %d, want more than %d", i+1, got, prev)
+		}
+		prev = got
+	}
+}
@@ -0,0 +1,143 @@
+package cardanoasset
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrTooManyRunes is returned when a name exceeds a caller-supplied rune limit.
+var ErrTooManyRunes = errors.New("asset name has too many runes")
+
+// minDoubleEncodedLength is the shortest name LooksDoubleEncoded flags.
+// Shorter hex-looking names such as "cafe" or "00" are too often genuine.
+const minDoubleEncodedLength = 8
+
+// URLSafeName returns the raw asset name bytes percent-encoded for safe use in
+// a URL path segment or query value. Every byte except the RFC 3986
+// unreserved characters (A-Z, a-z, 0-9, '-', '.', '_', '~') is escaped as
+// %XX, so binary names round-trip exactly through ParseURLSafeName.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "Space Bud#0")
+//	s := a.URLSafeName() // "Space%20Bud%230"
+func (a Asset) URLSafeName() string {
+	const upperHex = "0123456789ABCDEF"
+	var b strings.Builder
+	b.Grow(len(a.AssetName) * 3)
+	for i := 0; i < len(a.AssetName); i++ {
+		c := a.AssetName[i]
+		if isURLUnreserved(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(upperHex[c>>4])
+		b.WriteByte(upperHex[c&0x0f])
+	}
+	return b.String()
+}
+
+// ParseURLSafeName decodes a percent-encoded asset name produced by
+// URLSafeName back to the raw name bytes.
+// Returns ErrInvalidHex for malformed escapes and ErrAssetNameTooLong if the
+// decoded name exceeds 32 bytes.
+//
+// Example:
+//
+//	name, err := cardanoasset.ParseURLSafeName("Space%20Bud%230") // "Space Bud#0"
+func ParseURLSafeName(s string) (string, error) {
+	name, err := url.PathUnescape(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	if len(name) > MaxAssetNameLength {
+		return "", ErrAssetNameTooLong
+	}
+	return name, nil
+}
+
+// isURLUnreserved reports whether c is an RFC 3986 unreserved character.
+func isURLUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// RuneCount returns the number of runes in the asset name, counting each
+// invalid UTF-8 byte as one rune. Multi-byte characters such as emoji mean a
+// 32-byte name may hold far fewer than 32 runes.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "🚀🚀")
+//	n := a.RuneCount() // 2
+func (a Asset) RuneCount() int {
+	return utf8.RuneCountInString(a.AssetName)
+}
+
+// ValidateNameRunes checks that name has at most maxRunes runes, for display
+// systems that cap names by character count. This is an off-chain limit
+// applied on top of the ledger's, which stays byte-based: a name must also
+// fit in 32 bytes regardless of its rune count.
+// Returns an error wrapping ErrTooManyRunes with the actual count.
+//
+// Example:
+//
+//	err := cardanoasset.ValidateNameRunes("🚀🚀🚀", 2) // ErrTooManyRunes
+func ValidateNameRunes(name string, maxRunes int) error {
+	if n := utf8.RuneCountInString(name); n > maxRunes {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyRunes, n, maxRunes)
+	}
+	return nil
+}
+
+// LooksDoubleEncoded reports whether name, passed as a raw name to NewAsset,
+// looks like it was already hex-encoded: an even-length string of at least 8
+// lowercase hex digits. Such a name is encoded a second time, giving an asset
+// ID and fingerprint that match nothing on chain; use NewAssetFromHex for hex.
+//
+// This is an advisory heuristic for linting user input. It has false
+// positives, since a genuine on-chain name may consist of hex digits, so
+// warn rather than reject.
+//
+// Example:
+//
+//	cardanoasset.LooksDoubleEncoded("537061636542756430") // true
+//	cardanoasset.LooksDoubleEncoded("SpaceBud0")          // false
+func LooksDoubleEncoded(name string) bool {
+	return len(name) >= minDoubleEncodedLength && IsCanonicalHex(name)
+}
+
+// NameField returns the metadata key and value for the asset name, the way
+// many indexers emit it: ("name", name) when the name is valid UTF-8 made of
+// printable characters (spaces included), otherwise ("nameHex", hex) so that
+// binary names such as CIP-67 labeled ones survive text output intact.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBud0")
+//	key, value := a.NameField() // "name", "SpaceBud0"
+func (a Asset) NameField() (key string, value string) {
+	if !isPrintableName(a.AssetName) {
+		return "nameHex", a.AssetNameHex()
+	}
+	return "name", a.AssetName
+}
+
+// isPrintableName reports whether name is valid UTF-8 made only of printable
+// characters (spaces included), i.e. safe to show as text without loss.
+func isPrintableName(name string) bool {
+	if !utf8.ValidString(name) {
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package cardanoasset
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestURLSafeName(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+		want      string
+	}{
+		{name: "ascii", assetName: "SpaceBud0", want: "SpaceBud0"},
+		{name: "unreserved punctuation", assetName: "a-b.c_d~e", want: "a-b.c_d~e"},
+		{name: "reserved characters", assetName: "Space Bud#0/?", want: "Space%20Bud%230%2F%3F"},
+		{name: "binary cip-68 name", assetName: "\x00\x0d\xe1\x40NFT", want: "%00%0D%E1%40NFT"},
+		{name: "percent sign", assetName: "100%", want: "100%25"},
+		{name: "empty", assetName: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			got := a.URLSafeName()
+			if got != tt.want {
+				t.Fatalf("URLSafeName() = %q, want %q", got, tt.want)
+			}
+			back, err := ParseURLSafeName(got)
+			if err != nil {
+				t.Fatalf("ParseURLSafeName(%q) error = %v", got, err)
+			}
+			if back != tt.assetName {
+				t.Errorf("ParseURLSafeName(%q) = %q, want %q", got, back, tt.assetName)
+			}
+		})
+	}
+}
+
+func TestParseURLSafeNameErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{name: "truncated escape", in: "abc%2", wantErr: ErrInvalidHex},
+		{name: "non-hex escape", in: "%zz", wantErr: ErrInvalidHex},
+		{name: "too long", in: strings.Repeat("%41", MaxAssetNameLength+1), wantErr: ErrAssetNameTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseURLSafeName(tt.in); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseURLSafeName(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuneCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+		want      int
+	}{
+		{name: "ascii", assetName: "SpaceBud0", want: 9},
+		{name: "empty", assetName: "", want: 0},
+		{name: "emoji at byte limit", assetName: strings.Repeat("🚀", 8), want: 8},
+		{name: "mixed emoji and ascii", assetName: "Bud🚀🚀🚀🚀🚀🚀🚀", want: 10},
+		{name: "invalid utf-8 counts per byte", assetName: "\x00\x0d\xe1\x40", want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAsset(testPolicy, tt.assetName)
+			if err != nil {
+				t.Fatalf("NewAsset() error = %v", err)
+			}
+			if got := a.RuneCount(); got != tt.want {
+				t.Errorf("RuneCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNameRunes(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		maxRunes int
+		wantErr  error
+	}{
+		{name: "emoji at byte limit within rune cap", in: strings.Repeat("🚀", 8), maxRunes: 8},
+		{name: "emoji at byte limit over rune cap", in: strings.Repeat("🚀", 8), maxRunes: 7, wantErr: ErrTooManyRunes},
+		{name: "ascii under cap", in: "SpaceBud0", maxRunes: 32},
+		{name: "ascii over cap", in: "SpaceBud0", maxRunes: 8, wantErr: ErrTooManyRunes},
+		{name: "empty", in: "", maxRunes: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNameRunes(tt.in, tt.maxRunes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateNameRunes(%q, %d) error = %v, want %v", tt.in, tt.maxRunes, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateNameRunesByteLimitStillApplies(t *testing.T) {
+	// Nine rockets is nine runes but 36 bytes: a generous rune cap does not
+	// lift the on-chain byte limit.
+	name := strings.Repeat("🚀", 9)
+	if err := ValidateNameRunes(name, 32); err != nil {
+		t.Fatalf("ValidateNameRunes() error = %v", err)
+	}
+	if _, err := NewAsset(testPolicy, name); !errors.Is(err, ErrAssetNameTooLong) {
+		t.Errorf("NewAsset() error = %v, want %v", err, ErrAssetNameTooLong)
+	}
+}
+
+func TestLooksDoubleEncoded(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{name: "hex of SpaceBud0", in: "537061636542756430", want: true},
+		{name: "plain name", in: "SpaceBud0", want: false},
+		{name: "at minimum length", in: "deadbeef", want: true},
+		{name: "below minimum length", in: "beef", want: false},
+		{name: "odd length", in: "537061636542756", want: false},
+		{name: "uppercase hex", in: "537061636542756A", want: false},
+		{name: "empty", in: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LooksDoubleEncoded(tt.in); got != tt.want {
+				t.Errorf("LooksDoubleEncoded(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameField(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetName string
+		wantKey   string
+		wantValue string
+	}{
+		{name: "printable", assetName: "SpaceBud0", wantKey: "name", wantValue: "SpaceBud0"},
+		{name: "printable with spaces and emoji", assetName: "Space Bud 🚀", wantKey: "name", wantValue: "Space Bud 🚀"},
+		{name: "binary cip-68 name", assetName: "\x00\x0d\xe1\x40NFT", wantKey: "nameHex", wantValue: "000de1404e4654"},
+		{name: "invalid utf-8", assetName: "\xff\xfe", wantKey: "nameHex", wantValue: "fffe"},
+		{name: "control character", assetName: "Bud\n0", wantKey: "nameHex", wantValue: "4275640a30"},
+		{name: "empty", assetName: "", wantKey: "name", wantValue: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Asset{PolicyID: testPolicy, AssetName: tt.assetName}
+			key, value := a.NameField()
+			if key != tt.wantKey || value != tt.wantValue {
+				t.Errorf("NameField() = (%q, %q), want (%q, %q)", key, value, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package cardanoasset
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// ComposedLatinName returns the asset name with accented Latin letters
+// composed, so that a decomposed on-chain name such as "Cafe\u0301" compares
+// equal to the precomposed "Caf\u00e9" a user typed. See ComposeLatinName for
+// coverage; this is not full Unicode normalization.
+//
+// This is strictly a display and search helper. The on-chain name bytes are
+// canonical: never compose a name before computing its fingerprint, asset
+// ID or unit, or the result will identify a different asset.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAsset("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "Cafe\u0301")
+//	ok := a.ComposedLatinName() == cardanoasset.ComposeLatinName("Caf\u00e9") // true
+func (a Asset) ComposedLatinName() string {
+	return ComposeLatinName(a.AssetName)
+}
+
+// ComposeLatinName is a limited, dependency-free composer for accented Latin
+// names. It replaces a base letter followed by exactly one combining mark
+// with the precomposed character from the Latin-1 Supplement or Latin
+// Extended-A blocks (U+00C0 to U+017F), matching NFC for those pairs.
+//
+// It is not a full NFC implementation: it does no canonical reordering and
+// no composition outside those blocks, so a letter followed by two or more
+// combining marks, such as "e\u0301\u0323", is left unchanged rather than
+// half-composed. Other sequences and invalid UTF-8 are returned as is.
+//
+// Like ComposedLatinName, the result is for comparison only and must not be
+// used to build on-chain identifiers.
+//
+// Example:
+//
+//	s := cardanoasset.ComposeLatinName("Poke\u0301mon") // "Pok\u00e9mon"
+func ComposeLatinName(s string) string {
+	if !utf8.ValidString(s) {
+		return s
+	}
+	runes := []rune(s)
+	out := runes[:0]
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if i+1 < len(runes) && (i+2 == len(runes) || !unicode.Is(unicode.Mn, runes[i+2])) {
+			if c, ok := latinCompositions[[2]rune{r, runes[i+1]}]; ok {
+				out = append(out, c)
+				i++
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// latinCompositions maps a base letter and combining mark to the precomposed
+// character NFC produces for them, for U+00C0 to U+017F. Generated from the
+// Unicode Character Database canonical decompositions.
+var latinCompositions = map[[2]rune]rune{
+	{0x0041, 0x0300}: 0x00C0, // À
+	{0x0041, 0x0301}: 0x00C1, // Á
+	{0x0041, 0x0302}: 0x00C2, // Â
+	{0x0041, 0x0303}: 0x00C3, // Ã
+	{0x0041, 0x0308}: 0x00C4, // Ä
+	{0x0041, 0x030A}: 0x00C5, // Å
+	{0x0043, 0x0327}: 0x00C7, // Ç
+	{0x0045, 0x0300}: 0x00C8, // È
+	{0x0045, 0x0301}: 0x00C9, // É
+	{0x0045, 0x0302}: 0x00CA, // Ê
+	{0x0045, 0x0308}: 0x00CB, // Ë
+	{0x0049, 0x0300}: 0x00CC, // Ì
+	{0x0049, 0x0301}: 0x00CD, // Í
+	{0x0049, 0x0302}: 0x00CE, // Î
+	{0x0049, 0x0308}: 0x00CF, // Ï
+	{0x004E, 0x0303}: 0x00D1, // Ñ
+	{0x004F, 0x0300}: 0x00D2, // Ò
+	{0x004F, 0x0301}: 0x00D3, // Ó
+	{0x004F, 0x0302}: 0x00D4, // Ô
+	{0x004F, 0x0303}: 0x00D5, // Õ
+	{0x004F, 0x0308}: 0x00D6, // Ö
+	{0x0055, 0x0300}: 0x00D9, // Ù
+	{0x0055, 0x0301}: 0x00DA, // Ú
+	{0x0055, 0x0302}: 0x00DB, // Û
+	{0x0055, 0x0308}: 0x00DC, // Ü
+	{0x0059, 0x0301}: 0x00DD, // Ý
+	{0x0061, 0x0300}: 0x00E0, // à
+	{0x0061, 0x0301}: 0x00E1, // á
+	{0x0061, 0x0302}: 0x00E2, // â
+	{0x0061, 0x0303}: 0x00E3, // ã
+	{0x0061, 0x0308}: 0x00E4, // ä
+	{0x0061, 0x030A}: 0x00E5, // å
+	{0x0063, 0x0327}: 0x00E7, // ç
+	{0x0065, 0x0300}: 0x00E8, // è
+	{0x0065, 0x0301}: 0x00E9, // é
+	{0x0065, 0x0302}: 0x00EA, // ê
+	{0x0065, 0x0308}: 0x00EB, // ë
+	{0x0069, 0x0300}: 0x00EC, // ì
+	{0x0069, 0x0301}: 0x00ED, // í
+	{0x0069, 0x0302}: 0x00EE, // î
+	{0x0069, 0x0308}: 0x00EF, // ï
+	{0x006E, 0x0303}: 0x00F1, // ñ
+	{0x006F, 0x0300}: 0x00F2, // ò
+	{0x006F, 0x0301}: 0x00F3, // ó
+	{0x006F, 0x0302}: 0x00F4, // ô
+	{0x006F, 0x0303}: 0x00F5, // õ
+	{0x006F, 0x0308}: 0x00F6, // ö
+	{0x0075, 0x0300}: 0x00F9, // ù
+	{0x0075, 0x0301}: 0x00FA, // ú
+	{0x0075, 0x0302}: 0x00FB, // û
+	{0x0075, 0x0308}: 0x00FC, // ü
+	{0x0079, 0x0301}: 0x00FD, // ý
+	{0x0079, 0x0308}: 0x00FF, // ÿ
+	{0x0041, 0x0304}: 0x0100, // Ā
+	{0x0061, 0x0304}: 0x0101, // ā
+	{0x0041, 0x0306}: 0x0102, // Ă
+	{0x0061, 0x0306}: 0x0103, // ă
+	{0x0041, 0x0328}: 0x0104, // Ą
+	{0x0061, 0x0328}: 0x0105, // ą
+	{0x0043, 0x0301}: 0x0106, // Ć
+	{0x0063, 0x0301}: 0x0107, // ć
+	{0x0043, 0x0302}: 0x0108, // Ĉ
+	{0x0063, 0x0302}: 0x0109, // ĉ
+	{0x0043, 0x0307}: 0x010A, // Ċ
+	{0x0063, 0x0307}: 0x010B, // ċ
+	{0x0043, 0x030C}: 0x010C, // Č
+	{0x0063, 0x030C}: 0x010D, // č
+	{0x0044, 0x030C}: 0x010E, // Ď
+	{0x0064, 0x030C}: 0x010F, // ď
+	{0x0045, 0x0304}: 0x0112, // Ē
+	{0x0065, 0x0304}: 0x0113, // ē
+	{0x0045, 0x0306}: 0x0114, // Ĕ
+	{0x0065, 0x0306}: 0x0115, // ĕ
+	{0x0045, 0x0307}: 0x0116, // Ė
+	{0x0065, 0x0307}: 0x0117, // ė
+	{0x0045, 0x0328}: 0x0118, // Ę
+	{0x0065, 0x0328}: 0x0119, // ę
+	{0x0045, 0x030C}: 0x011A, // Ě
+	{0x0065, 0x030C}: 0x011B, // ě
+	{0x0047, 0x0302}: 0x011C, // Ĝ
+	{0x0067, 0x0302}: 0x011D, // ĝ
+	{0x0047, 0x0306}: 0x011E, // Ğ
+	{0x0067, 0x0306}: 0x011F, // ğ
+	{0x0047, 0x0307}: 0x0120, // Ġ
+	{0x0067, 0x0307}: 0x0121, // ġ
+	{0x0047, 0x0327}: 0x0122, // Ģ
+	{0x0067, 0x0327}: 0x0123, // ģ
+	{0x0048, 0x0302}: 0x0124, // Ĥ
+	{0x0068, 0x0302}: 0x0125, // ĥ
+	{0x0049, 0x0303}: 0x0128, // Ĩ
+	{0x0069, 0x0303}: 0x0129, // ĩ
+	{0x0049, 0x0304}: 0x012A, // Ī
+	{0x0069, 0x0304}: 0x012B, // ī
+	{0x0049, 0x0306}: 0x012C, // Ĭ
+	{0x0069, 0x0306}: 0x012D, // ĭ
+	{0x0049, 0x0328}: 0x012E, // Į
+	{0x0069, 0x0328}: 0x012F, // į
+	{0x0049, 0x0307}: 0x0130, // İ
+	{0x004A, 0x0302}: 0x0134, // Ĵ
+	{0x006A, 0x0302}: 0x0135, // ĵ
+	{0x004B, 0x0327}: 0x0136, // Ķ
+	{0x006B, 0x0327}: 0x0137, // ķ
+	{0x004C, 0x0301}: 0x0139, // Ĺ
+	{0x006C, 0x0301}: 0x013A, // ĺ
+	{0x004C, 0x0327}: 0x013B, // Ļ
+	{0x006C, 0x0327}: 0x013C, // ļ
+	{0x004C, 0x030C}: 0x013D, // Ľ
+	{0x006C, 0x030C}: 0x013E, // ľ
+	{0x004E, 0x0301}: 0x0143, // Ń
+	{0x006E, 0x0301}: 0x0144, // ń
+	{0x004E, 0x0327}: 0x0145, // Ņ
+	{0x006E, 0x0327}: 0x0146, // ņ
+	{0x004E, 0x030C}: 0x0147, // Ň
+	{0x006E, 0x030C}: 0x0148, // ň
+	{0x004F, 0x0304}: 0x014C, // Ō
+	{0x006F, 0x0304}: 0x014D, // ō
+	{0x004F, 0x0306}: 0x014E, // Ŏ
+	{0x006F, 0x0306}: 0x014F, // ŏ
+	{0x004F, 0x030B}: 0x0150, // Ő
+	{0x006F, 0x030B}: 0x0151, // ő
+	{0x0052, 0x0301}: 0x0154, // Ŕ
+	{0x0072, 0x0301}: 0x0155, // ŕ
+	{0x0052, 0x0327}: 0x0156, // Ŗ
+	{0x0072, 0x0327}: 0x0157, // ŗ
+	{0x0052, 0x030C}: 0x0158, // Ř
+	{0x0072, 0x030C}: 0x0159, // ř
+	{0x0053, 0x0301}: 0x015A, // Ś
+	{0x0073, 0x0301}: 0x015B, // ś
+	{0x0053, 0x0302}: 0x015C, // Ŝ
+	{0x0073, 0x0302}: 0x015D, // ŝ
+	{0x0053, 0x0327}: 0x015E, // Ş
+	{0x0073, 0x0327}: 0x015F, // ş
+	{0x0053, 0x030C}: 0x0160, // Š
+	{0x0073, 0x030C}: 0x0161, // š
+	{0x0054, 0x0327}: 0x0162, // Ţ
+	{0x0074, 0x0327}: 0x0163, // ţ
+	{0x0054, 0x030C}: 0x0164, // Ť
+	{0x0074, 0x030C}: 0x0165, // ť
+	{0x0055, 0x0303}: 0x0168, // Ũ
+	{0x0075, 0x0303}: 0x0169, // ũ
+	{0x0055, 0x0304}: 0x016A, // Ū
+	{0x0075, 0x0304}: 0x016B, // ū
+	{0x0055, 0x0306}: 0x016C, // Ŭ
+	{0x0075, 0x0306}: 0x016D, // ŭ
+	{0x0055, 0x030A}: 0x016E, // Ů
+	{0x0075, 0x030A}: 0x016F, // ů
+	{0x0055, 0x030B}: 0x0170, // Ű
+	{0x0075, 0x030B}: 0x0171, // ű
+	{0x0055, 0x0328}: 0x0172, // Ų
+	{0x0075, 0x0328}: 0x0173, // ų
+	{0x0057, 0x0302}: 0x0174, // Ŵ
+	{0x0077, 0x0302}: 0x0175, // ŵ
+	{0x0059, 0x0302}: 0x0176, // Ŷ
+	{0x0079, 0x0302}: 0x0177, // ŷ
+	{0x0059, 0x0308}: 0x0178, // Ÿ
+	{0x005A, 0x0301}: 0x0179, // Ź
+	{0x007A, 0x0301}: 0x017A, // ź
+	{0x005A, 0x0307}: 0x017B, // Ż
+	{0x007A, 0x0307}: 0x017C, // ż
+	{0x005A, 0x030C}: 0x017D, // Ž
+	{0x007A, 0x030C}: 0x017E, // ž
+}
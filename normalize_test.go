@@ -0,0 +1,60 @@
+package cardanoasset
+
+import "testing"
+
+func TestComposeLatinName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "combining acute", in: "Cafe\u0301", want: "Caf\u00e9"},
+		{name: "already precomposed", in: "Caf\u00e9", want: "Caf\u00e9"},
+		{name: "several marks", in: "Poke\u0301mon A\u0300 n\u0303", want: "Pok\u00e9mon \u00c0 \u00f1"},
+		{name: "latin extended-a", in: "Z\u030c", want: "\u017d"},
+		{name: "uncovered sequence unchanged", in: "q\u0301", want: "q\u0301"},
+		{name: "leading combining mark", in: "\u0301e", want: "\u0301e"},
+		{name: "two marks left unchanged", in: "e\u0301\u0323", want: "e\u0301\u0323"},
+		{name: "two marks in canonical order left unchanged", in: "e\u0323\u0301", want: "e\u0323\u0301"},
+		{name: "three marks left unchanged", in: "A\u0300\u0301\u0301", want: "A\u0300\u0301\u0301"},
+		{name: "mark before next base", in: "e\u0301e\u0301", want: "\u00e9\u00e9"},
+		{name: "ascii", in: "SpaceBud0", want: "SpaceBud0"},
+		{name: "invalid utf-8 unchanged", in: "\x00\x0d\xe1\x40e\u0301", want: "\x00\x0d\xe1\x40e\u0301"},
+		{name: "empty", in: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComposeLatinName(tt.in); got != tt.want {
+				t.Errorf("ComposeLatinName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComposedLatinNameDoesNotAffectIdentity(t *testing.T) {
+	decomposed, err := NewAsset(testPolicy, "Cafe\u0301")
+	if err != nil {
+		t.Fatal(err)
+	}
+	precomposed, err := NewAsset(testPolicy, "Caf\u00e9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decomposed.ComposedLatinName() != precomposed.ComposedLatinName() {
+		t.Errorf("ComposedLatinName() = %q and %q, want equal", decomposed.ComposedLatinName(), precomposed.ComposedLatinName())
+	}
+	if decomposed.AssetName != "Cafe\u0301" {
+		t.Errorf("ComposedLatinName() mutated AssetName to %q", decomposed.AssetName)
+	}
+	fpDecomposed, err := decomposed.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fpPrecomposed, err := precomposed.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fpDecomposed == fpPrecomposed {
+		t.Error("decomposed and precomposed names share a fingerprint; on-chain bytes must stay distinct")
+	}
+}
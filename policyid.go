@@ -0,0 +1,56 @@
+package cardanoasset
+
+import "encoding/hex"
+
+// PolicyID is a policy ID known to be valid: 56 lowercase hex characters.
+// Values obtained from ParsePolicyID carry that guarantee, so code that
+// builds many assets under one policy can validate it once. Converting an
+// arbitrary string with PolicyID(s) bypasses the check and voids it.
+type PolicyID string
+
+// ParsePolicyID validates s and returns it as a PolicyID.
+// Returns a *ValidationError wrapping ErrInvalidPolicyID if s is not 56
+// lowercase hex characters.
+//
+// Example:
+//
+//	p, err := cardanoasset.ParsePolicyID("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+func ParsePolicyID(s string) (PolicyID, error) {
+	if err := ValidatePolicyID(s); err != nil {
+		return "", newValidationError("policyId", s, err)
+	}
+	return PolicyID(s), nil
+}
+
+// Bytes returns the 28 decoded policy ID bytes, or nil if p is invalid.
+//
+// Example:
+//
+//	b := p.Bytes() // len(b) == 28
+func (p PolicyID) Bytes() []byte {
+	b, err := hex.DecodeString(string(p))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// String returns the policy ID as hex.
+func (p PolicyID) String() string {
+	return string(p)
+}
+
+// NewAssetUnderPolicy builds an Asset under p without re-validating the
+// policy ID, for tight loops that mint many names under one parsed policy.
+// The name length is not checked either; call Validate on the result when
+// the name is untrusted.
+//
+// Example:
+//
+//	p, _ := cardanoasset.ParsePolicyID("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+//	for i := 0; i < 10000; i++ {
+//	    a := cardanoasset.NewAssetUnderPolicy(p, fmt.Sprintf("SpaceBud%d", i))
+//	}
+func NewAssetUnderPolicy(p PolicyID, name string) Asset {
+	return Asset{PolicyID: string(p), AssetName: name}
+}
@@ -0,0 +1,85 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParsePolicyID(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{name: "valid", in: testPolicy},
+		{name: "uppercase", in: strings.ToUpper(testPolicy), wantErr: ErrInvalidPolicyID},
+		{name: "too short", in: testPolicy[:54], wantErr: ErrInvalidPolicyID},
+		{name: "transaction hash", in: strings.Repeat("ab", 32), wantErr: ErrInvalidPolicyID},
+		{name: "empty", in: "", wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePolicyID(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePolicyID(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if err != nil {
+				var ve *ValidationError
+				if !errors.As(err, &ve) || ve.Field != "policyId" {
+					t.Errorf("ParsePolicyID(%q) error = %#v, want *ValidationError for policyId", tt.in, err)
+				}
+				if p != "" {
+					t.Errorf("ParsePolicyID(%q) = %q on error, want empty", tt.in, p)
+				}
+				return
+			}
+			if p.String() != tt.in {
+				t.Errorf("String() = %q, want %q", p.String(), tt.in)
+			}
+			if got := hex.EncodeToString(p.Bytes()); got != tt.in || len(p.Bytes()) != PolicyIDLength {
+				t.Errorf("Bytes() = %s (%d bytes), want %s (%d bytes)", got, len(p.Bytes()), tt.in, PolicyIDLength)
+			}
+		})
+	}
+}
+
+func TestPolicyIDBytesInvalid(t *testing.T) {
+	if b := PolicyID("zz").Bytes(); b != nil {
+		t.Errorf("PolicyID(\"zz\").Bytes() = %x, want nil", b)
+	}
+}
+
+func TestNewAssetUnderPolicy(t *testing.T) {
+	p, err := ParsePolicyID(testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		assetName string
+	}{
+		{name: "named", assetName: "SpaceBud0"},
+		{name: "empty name", assetName: ""},
+		{name: "binary name", assetName: "\x00\x0d\xe1\x40NFT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewAssetUnderPolicy(p, tt.assetName)
+			want, err := NewAsset(testPolicy, tt.assetName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("NewAssetUnderPolicy() = %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	// The name is not validated; Validate reports an over-long one.
+	long := NewAssetUnderPolicy(p, strings.Repeat("x", MaxAssetNameLength+1))
+	if err := long.Validate(); !errors.Is(err, ErrAssetNameTooLong) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrAssetNameTooLong)
+	}
+}
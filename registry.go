@@ -0,0 +1,64 @@
+package cardanoasset
+
+import "sync"
+
+// knownPolicies maps policy IDs of well-known mainnet collections to display
+// names. It is a UX convenience, not an authoritative registry: anyone can
+// mint under a look-alike name, so only the policy ID identifies a collection.
+var (
+	knownPoliciesMu sync.RWMutex
+	knownPolicies   = map[string]string{
+		"d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc": "SpaceBudz",
+		"40fa2aa67258b4ce7b5782f74831d46a84c59a0ff0c28262fab21728": "Clay Nation",
+		"a0028f350aaabe0545fdcb56b039bfb08e4bb4d8c4d7c3c7d481c235": "HOSKY",
+		ADAHandlePolicyID: "ADA Handle",
+	}
+)
+
+// KnownPolicies returns a copy of the registry of well-known policy IDs and
+// their display names, including entries added with AddKnownPolicy.
+//
+// Example:
+//
+//	for policyID, name := range cardanoasset.KnownPolicies() {
+//	    fmt.Println(name, policyID)
+//	}
+func KnownPolicies() map[string]string {
+	knownPoliciesMu.RLock()
+	defer knownPoliciesMu.RUnlock()
+	policies := make(map[string]string, len(knownPolicies))
+	for policyID, name := range knownPolicies {
+		policies[policyID] = name
+	}
+	return policies
+}
+
+// LookupPolicyName returns the display name registered for policyID.
+// It is safe for concurrent use with AddKnownPolicy.
+//
+// Example:
+//
+//	name, ok := cardanoasset.LookupPolicyName("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc") // "SpaceBudz", true
+func LookupPolicyName(policyID string) (string, bool) {
+	knownPoliciesMu.RLock()
+	defer knownPoliciesMu.RUnlock()
+	name, ok := knownPolicies[policyID]
+	return name, ok
+}
+
+// AddKnownPolicy registers or renames a policy ID in the registry at runtime.
+// It is safe for concurrent use with lookups.
+// Returns ErrInvalidPolicyID if the policy ID is invalid.
+//
+// Example:
+//
+//	err := cardanoasset.AddKnownPolicy("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "SpaceBudz")
+func AddKnownPolicy(policyID, name string) error {
+	if err := ValidatePolicyID(policyID); err != nil {
+		return err
+	}
+	knownPoliciesMu.Lock()
+	defer knownPoliciesMu.Unlock()
+	knownPolicies[policyID] = name
+	return nil
+}
@@ -0,0 +1,88 @@
+package cardanoasset
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// withKnownPolicy registers policyID for the duration of the test.
+func withKnownPolicy(t *testing.T, policyID, name string) {
+	t.Helper()
+	if err := AddKnownPolicy(policyID, name); err != nil {
+		t.Fatalf("AddKnownPolicy(%q) error = %v", policyID, err)
+	}
+	t.Cleanup(func() {
+		knownPoliciesMu.Lock()
+		defer knownPoliciesMu.Unlock()
+		delete(knownPolicies, policyID)
+	})
+}
+
+func TestLookupPolicyName(t *testing.T) {
+	const added = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	withKnownPolicy(t, added, "Test Collection")
+	tests := []struct {
+		name     string
+		policyID string
+		want     string
+		wantOK   bool
+	}{
+		{name: "seeded", policyID: testPolicy, want: "SpaceBudz", wantOK: true},
+		{name: "seeded handle", policyID: ADAHandlePolicyID, want: "ADA Handle", wantOK: true},
+		{name: "runtime addition", policyID: added, want: "Test Collection", wantOK: true},
+		{name: "unknown", policyID: "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupPolicyName(tt.policyID)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("LookupPolicyName(%q) = (%q, %v), want (%q, %v)", tt.policyID, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAddKnownPolicyInvalid(t *testing.T) {
+	if err := AddKnownPolicy("abcd", "Nope"); !errors.Is(err, ErrInvalidPolicyID) {
+		t.Errorf("AddKnownPolicy error = %v, want %v", err, ErrInvalidPolicyID)
+	}
+}
+
+func TestKnownPoliciesIsCopy(t *testing.T) {
+	policies := KnownPolicies()
+	policies[testPolicy] = "Changed"
+	if name, _ := LookupPolicyName(testPolicy); name != "SpaceBudz" {
+		t.Errorf("mutating KnownPolicies() changed the registry to %q", name)
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	const added = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	t.Cleanup(func() {
+		knownPoliciesMu.Lock()
+		defer knownPoliciesMu.Unlock()
+		delete(knownPolicies, added)
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = AddKnownPolicy(added, "Concurrent")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				LookupPolicyName(added)
+				KnownPolicies()
+			}
+		}()
+	}
+	wg.Wait()
+	if name, ok := LookupPolicyName(added); !ok || name != "Concurrent" {
+		t.Errorf("LookupPolicyName = (%q, %v), want (%q, true)", name, ok, "Concurrent")
+	}
+}
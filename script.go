@@ -0,0 +1,34 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// nativeScriptTag is the language tag prefixed to a native script's CBOR
+// before hashing it into a script hash (policy ID).
+const nativeScriptTag = 0x00
+
+// ErrEmptyScript is returned when no script bytes are supplied.
+var ErrEmptyScript = errors.New("script CBOR is empty")
+
+// PolicyIDFromScript derives the policy ID of a native (timelock/multisig)
+// minting script: the blake2b-224 hash of the 0x00 native-script tag followed
+// by the script's CBOR encoding, hex-encoded to 56 characters. This lets
+// minting tools know the policy ID before submitting a transaction.
+// The CBOR is hashed as given and is not parsed or validated.
+// Returns ErrEmptyScript for empty input.
+//
+// Example:
+//
+//	// {"type": "sig", "keyHash": "..."} encoded as CBOR [0, h'<28-byte key hash>']
+//	policyID, err := cardanoasset.PolicyIDFromScript(scriptCBOR)
+func PolicyIDFromScript(scriptCBOR []byte) (string, error) {
+	if len(scriptCBOR) == 0 {
+		return "", ErrEmptyScript
+	}
+	tagged := make([]byte, 0, 1+len(scriptCBOR))
+	tagged = append(tagged, nativeScriptTag)
+	tagged = append(tagged, scriptCBOR...)
+	return hex.EncodeToString(blake2b224(tagged)), nil
+}
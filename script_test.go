@@ -0,0 +1,54 @@
+package cardanoasset
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestPolicyIDFromScript(t *testing.T) {
+	tests := []struct {
+		name      string
+		scriptHex string
+		want      string
+	}{
+		{
+			// {"type": "sig", "keyHash": "e09d36c7..."} = [0, h'<key hash>']
+			name:      "sig",
+			scriptHex: "8200581ce09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a",
+			want:      "208bdcaf2d83ae026964e23659c703a377473168a39cbdc2b0241115",
+		},
+		{
+			// {"type": "all", "scripts": [sig, {"type": "before", "slot": 100000000}]}
+			name:      "all of sig and before",
+			scriptHex: "8201828200581ce09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a82051a05f5e100",
+			want:      "16f7628ff327aeef4624631fe7d9f7f84d8a77633e646e530e18effe",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := hex.DecodeString(tt.scriptHex)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := PolicyIDFromScript(script)
+			if err != nil {
+				t.Fatalf("PolicyIDFromScript() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("PolicyIDFromScript() = %s, want %s", got, tt.want)
+			}
+			if _, err := NewAsset(got, "SpaceBud0"); err != nil {
+				t.Errorf("NewAsset(derived policy) error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPolicyIDFromScriptEmpty(t *testing.T) {
+	for _, in := range [][]byte{nil, {}} {
+		if _, err := PolicyIDFromScript(in); !errors.Is(err, ErrEmptyScript) {
+			t.Errorf("PolicyIDFromScript(%v) error = %v, want %v", in, err, ErrEmptyScript)
+		}
+	}
+}
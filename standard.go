@@ -0,0 +1,140 @@
+package cardanoasset
+
+// TokenStandard identifies the token standard an asset name is consistent with.
+type TokenStandard uint8
+
+const (
+	// StandardUnknown is used for empty names, which carry no standard hint.
+	StandardUnknown TokenStandard = iota
+	// StandardCIP25 is an unlabeled name, as used by CIP-25 (721 metadata)
+	// NFTs and by legacy fungible tokens alike.
+	StandardCIP25
+	// StandardCIP67 is a name with a valid CIP-67 label outside the CIP-68 set.
+	StandardCIP67
+	// StandardCIP68Reference is a CIP-68 (100) reference token.
+	StandardCIP68Reference
+	// StandardCIP68NFT is a CIP-68 (222) non-fungible user token.
+	StandardCIP68NFT
+	// StandardCIP68FT is a CIP-68 (333) fungible user token.
+	StandardCIP68FT
+	// StandardCIP68RFT is a CIP-68 (444) rich-fungible user token.
+	StandardCIP68RFT
+)
+
+// String returns a short human-readable name for the standard.
+func (s TokenStandard) String() string {
+	switch s {
+	case StandardCIP25:
+		return "CIP-25"
+	case StandardCIP67:
+		return "CIP-67"
+	case StandardCIP68Reference:
+		return "CIP-68 (100) reference"
+	case StandardCIP68NFT:
+		return "CIP-68 (222) NFT"
+	case StandardCIP68FT:
+		return "CIP-68 (333) FT"
+	case StandardCIP68RFT:
+		return "CIP-68 (444) RFT"
+	default:
+		return "unknown"
+	}
+}
+
+// Standard classifies the asset name by its CIP-67 label, if any.
+// Labeled names map to the matching CIP-68 standard (or StandardCIP67 for
+// other labels), unlabeled non-empty names to StandardCIP25, and the empty
+// name to StandardUnknown. Only the name is inspected; on-chain metadata is
+// what actually determines the standard.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	s := a.Standard() // StandardCIP68NFT
+func (a Asset) Standard() TokenStandard {
+	label, ok := a.Label()
+	if !ok {
+		if a.AssetName == "" {
+			return StandardUnknown
+		}
+		return StandardCIP25
+	}
+	switch label {
+	case LabelReferenceNFT:
+		return StandardCIP68Reference
+	case LabelNFT:
+		return StandardCIP68NFT
+	case LabelFT:
+		return StandardCIP68FT
+	case LabelRFT:
+		return StandardCIP68RFT
+	default:
+		return StandardCIP67
+	}
+}
+
+// IsLikelyNFT reports whether aa looks like a non-fungible token holding:
+// an amount of exactly 1 of an asset whose name classifies as CIP-25 or as a
+// CIP-68 222/444 user token.
+//
+// This is a heuristic. A single unit of a fungible token with an unlabeled
+// name is indistinguishable from a CIP-25 NFT by name and amount alone, and
+// an NFT's total supply is not visible from one holding. Check the minting
+// history or metadata when certainty matters.
+//
+// Example:
+//
+//	ok := cardanoasset.IsLikelyNFT(cardanoasset.AssetAmount{Asset: a, Amount: 1})
+func IsLikelyNFT(aa AssetAmount) bool {
+	if aa.Amount != 1 {
+		return false
+	}
+	switch aa.Asset.Standard() {
+	case StandardCIP25, StandardCIP68NFT, StandardCIP68RFT:
+		return true
+	default:
+		return false
+	}
+}
+
+// standardDetectors are the heuristics DetectStandards runs, in output order.
+// Supporting a new standard means appending a detector here.
+var standardDetectors = []func(Asset) (TokenStandard, bool){
+	// Label-based: the specific CIP-68 token class.
+	func(a Asset) (TokenStandard, bool) {
+		s := a.Standard()
+		switch s {
+		case StandardCIP68Reference, StandardCIP68NFT, StandardCIP68FT, StandardCIP68RFT:
+			return s, true
+		}
+		return StandardUnknown, false
+	},
+	// Structural: any valid CIP-67 label prefix.
+	func(a Asset) (TokenStandard, bool) {
+		_, ok := a.Label()
+		return StandardCIP67, ok
+	},
+	// Unlabeled, non-empty names used by CIP-25 and legacy tokens.
+	func(a Asset) (TokenStandard, bool) {
+		return StandardCIP25, a.Standard() == StandardCIP25
+	},
+}
+
+// DetectStandards returns every standard the asset name is consistent with,
+// rather than the single best match returned by Standard. A CIP-68 user token,
+// for example, yields both its label-based class and StandardCIP67 for the
+// label structure. Returns nil for the empty name.
+//
+// Example:
+//
+//	a, _ := cardanoasset.NewAssetFromHex("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc", "000de1404e4654")
+//	standards := a.DetectStandards() // [StandardCIP68NFT StandardCIP67]
+func (a Asset) DetectStandards() []TokenStandard {
+	var standards []TokenStandard
+	for _, detect := range standardDetectors {
+		if s, ok := detect(a); ok {
+			standards = append(standards, s)
+		}
+	}
+	return standards
+}
@@ -0,0 +1,58 @@
+package cardanoasset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsLikelyNFT(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		amount  uint64
+		want    bool
+	}{
+		{name: "cip-68 222 single", nameHex: "000de1404e4654", amount: 1, want: true},
+		{name: "cip-68 444 single", nameHex: "001bc2804e4654", amount: 1, want: true},
+		{name: "cip-25 single", nameHex: "537061636542756430", amount: 1, want: true},
+		{name: "cip-68 222 many", nameHex: "000de1404e4654", amount: 1000, want: false},
+		{name: "cip-25 many", nameHex: "537061636542756430", amount: 1000, want: false},
+		{name: "cip-68 333 fungible single", nameHex: "0014df10544f4b454e", amount: 1, want: false},
+		{name: "cip-68 100 reference single", nameHex: "000643b04e4654", amount: 1, want: false},
+		{name: "other cip-67 label single", nameHex: "000010704e4654", amount: 1, want: false},
+		{name: "empty name single", nameHex: "", amount: 1, want: false},
+		{name: "zero amount", nameHex: "000de1404e4654", amount: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aa := AssetAmount{Asset: mustAssetFromHex(t, testPolicy, tt.nameHex), Amount: tt.amount}
+			if got := IsLikelyNFT(aa); got != tt.want {
+				t.Errorf("IsLikelyNFT(%s, %d) = %v, want %v (standard %v)", tt.nameHex, tt.amount, got, tt.want, aa.Asset.Standard())
+			}
+		})
+	}
+}
+
+func TestDetectStandards(t *testing.T) {
+	tests := []struct {
+		name    string
+		nameHex string
+		want    []TokenStandard
+	}{
+		{name: "cip-68 222", nameHex: "000de1404e4654", want: []TokenStandard{StandardCIP68NFT, StandardCIP67}},
+		{name: "cip-68 100", nameHex: "000643b04e4654", want: []TokenStandard{StandardCIP68Reference, StandardCIP67}},
+		{name: "cip-68 333", nameHex: "0014df10544f4b", want: []TokenStandard{StandardCIP68FT, StandardCIP67}},
+		{name: "cip-68 444", nameHex: "001bc2804e4654", want: []TokenStandard{StandardCIP68RFT, StandardCIP67}},
+		{name: "other cip-67 label", nameHex: "000010704e4654", want: []TokenStandard{StandardCIP67}},
+		{name: "unlabeled", nameHex: "537061636542756430", want: []TokenStandard{StandardCIP25}},
+		{name: "empty", nameHex: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustAssetFromHex(t, testPolicy, tt.nameHex)
+			if got := a.DetectStandards(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectStandards() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package cardanoasset
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseAssetIDStream reads one asset ID per line from r, as in a
+// hand-edited allowlist, and parses each with ParseAssetID. Leading and
+// trailing whitespace is ignored, and blank lines and lines starting with
+// "#" after trimming are skipped.
+// Returns an error naming the 1-based line number that wraps the
+// ParseAssetID error, or the read error.
+//
+// Example:
+//
+//	f, _ := os.Open("allowlist.txt")
+//	defer f.Close()
+//	assets, err := cardanoasset.ParseAssetIDStream(f)
+func ParseAssetIDStream(r io.Reader) ([]Asset, error) {
+	var assets []Asset
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		a, err := ParseAssetID(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		assets = append(assets, a)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("reading asset IDs: %w", err)
+	}
+	return assets, nil
+}
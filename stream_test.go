@@ -0,0 +1,73 @@
+package cardanoasset
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestParseAssetIDStream(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	tests := []struct {
+		name        string
+		in          string
+		want        []Asset
+		wantErr     error
+		wantErrText string
+	}{
+		{
+			name: "comments blank lines and indentation",
+			in: "# SpaceBudz allowlist\n" +
+				"\n" +
+				"  " + testPolicy + ".537061636542756430\n" +
+				"\t# indented comment\n" +
+				"   \n" +
+				testPolicy + ".537061636542756431  \n",
+			want: []Asset{bud0, bud1},
+		},
+		{name: "crlf line endings", in: testPolicy + ".537061636542756430\r\n" + testPolicy + "\r\n", want: []Asset{bud0, {PolicyID: testPolicy}}},
+		{name: "no trailing newline", in: testPolicy + ".537061636542756430", want: []Asset{bud0}},
+		{name: "only comments", in: "# nothing here\n\n", want: nil},
+		{
+			name: "bad entry reports line number",
+			in: "# header\n" +
+				"\n" +
+				testPolicy + ".537061636542756430\n" +
+				"  " + testPolicy + ".zz\n" +
+				testPolicy + ".537061636542756431\n",
+			wantErr:     ErrInvalidHex,
+			wantErrText: "line 4:",
+		},
+		{name: "fingerprint entry", in: "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3\n", wantErr: ErrIsFingerprint, wantErrText: "line 1:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAssetIDStream(strings.NewReader(tt.in))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAssetIDStream() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !strings.HasPrefix(err.Error(), tt.wantErrText) {
+					t.Errorf("ParseAssetIDStream() error = %q, want prefix %q", err, tt.wantErrText)
+				}
+				if got != nil {
+					t.Errorf("ParseAssetIDStream() = %v on error, want nil", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAssetIDStream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAssetIDStreamReadError(t *testing.T) {
+	errBoom := errors.New("boom")
+	if _, err := ParseAssetIDStream(iotest.ErrReader(errBoom)); !errors.Is(err, errBoom) {
+		t.Errorf("ParseAssetIDStream() error = %v, want %v", err, errBoom)
+	}
+}
@@ -0,0 +1,408 @@
+package cardanoasset
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Lovelace is the sentinel Asset under which a Value stores its ADA amount,
+// measured in lovelace. It has an empty policy ID, which no native token can
+// have, so it never collides with a real asset.
+var Lovelace = Asset{}
+
+// cliLovelaceUnit is the unit cardano-cli uses for ADA amounts.
+const cliLovelaceUnit = "lovelace"
+
+// ErrInvalidCLIValue is returned when a cardano-cli value string is malformed.
+var ErrInvalidCLIValue = errors.New("invalid cardano-cli value")
+
+// Value is a multi-asset bundle mapping each asset to its quantity, mirroring
+// the ledger's value type. ADA is stored under the Lovelace key.
+// A Value is a map, so methods that modify it require a non-nil Value and are
+// not safe for concurrent use.
+type Value map[Asset]uint64
+
+// Get returns the quantity of asset a held in v, or 0 if absent.
+//
+// Example:
+//
+//	ada := v.Get(cardanoasset.Lovelace)
+func (v Value) Get(a Asset) uint64 {
+	return v[a]
+}
+
+// Add increases the quantity of asset a by amount.
+// Returns ErrAmountOverflow, leaving v unchanged, if the total exceeds uint64.
+//
+// Example:
+//
+//	v := cardanoasset.Value{}
+//	err := v.Add(cardanoasset.Lovelace, 1500000)
+func (v Value) Add(a Asset, amount uint64) error {
+	sum, err := addAmounts(v[a], amount)
+	if err != nil {
+		return err
+	}
+	v[a] = sum
+	return nil
+}
+
+// Sub decreases the quantity of asset a by amount, removing the entry when it
+// reaches zero. Returns ErrAmountUnderflow, leaving v unchanged, if v holds
+// less than amount.
+//
+// Example:
+//
+//	err := v.Sub(cardanoasset.Lovelace, 170000)
+func (v Value) Sub(a Asset, amount uint64) error {
+	held := v[a]
+	if amount > held {
+		return ErrAmountUnderflow
+	}
+	if held == amount {
+		delete(v, a)
+		return nil
+	}
+	v[a] = held - amount
+	return nil
+}
+
+// Merge returns a new Value holding the per-asset sum of v and other.
+// Neither input is modified. Returns ErrAmountOverflow if any sum exceeds
+// uint64.
+//
+// Example:
+//
+//	total, err := utxo1.Merge(utxo2)
+func (v Value) Merge(other Value) (Value, error) {
+	merged := make(Value, len(v)+len(other))
+	for a, amount := range v {
+		merged[a] = amount
+	}
+	for a, amount := range other {
+		if err := merged.Add(a, amount); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// Diff returns a new Value holding v - other per asset, treating assets
+// absent from either side as zero and dropping entries that reach zero. It
+// is the inverse of Merge and computes, for example, what remains after
+// paying other out of v. Neither input is modified.
+// Returns an error wrapping ErrAmountUnderflow, naming the asset, if other
+// holds more of any asset than v.
+//
+// Example:
+//
+//	change, err := inputs.Diff(outputs)
+func (v Value) Diff(other Value) (Value, error) {
+	diff := make(Value, len(v))
+	for a, amount := range v {
+		if amount > 0 {
+			diff[a] = amount
+		}
+	}
+	for a, amount := range other {
+		if err := diff.Sub(a, amount); err != nil {
+			unit := a.AssetID()
+			if a == Lovelace {
+				unit = cliLovelaceUnit
+			}
+			return nil, fmt.Errorf("%w: %s", err, unit)
+		}
+	}
+	return diff, nil
+}
+
+// IsADAOnly reports whether v holds no native tokens: every non-zero entry
+// is Lovelace. An empty Value is ADA-only.
+//
+// Example:
+//
+//	if utxo.Value.IsADAOnly() {
+//	    collateral = append(collateral, utxo)
+//	}
+func (v Value) IsADAOnly() bool {
+	for a, amount := range v {
+		if a != Lovelace && amount > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// AssetCount returns the number of distinct native tokens held in v.
+// Lovelace and zero-amount entries are not counted.
+//
+// Example:
+//
+//	fmt.Printf("%d tokens across %d policies\n", v.AssetCount(), len(v.Policies()))
+func (v Value) AssetCount() int {
+	n := 0
+	for a, amount := range v {
+		if a != Lovelace && amount > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// TotalQuantity returns the sum of all native token quantities in v,
+// excluding Lovelace. Quantities of different tokens are not comparable, so
+// this is only meaningful as a display aggregate.
+// Returns ErrAmountOverflow if the sum exceeds uint64.
+//
+// Example:
+//
+//	total, err := v.TotalQuantity()
+func (v Value) TotalQuantity() (uint64, error) {
+	var total uint64
+	for a, amount := range v {
+		if a == Lovelace {
+			continue
+		}
+		sum, err := addAmounts(total, amount)
+		if err != nil {
+			return 0, err
+		}
+		total = sum
+	}
+	return total, nil
+}
+
+// Filter returns a new Value containing only the entries of v for which pred
+// returns true. v is not modified.
+//
+// Example:
+//
+//	nfts := v.Filter(func(a cardanoasset.Asset, amount uint64) bool {
+//	    return a != cardanoasset.Lovelace && amount == 1
+//	})
+func (v Value) Filter(pred func(Asset, uint64) bool) Value {
+	filtered := make(Value)
+	for a, amount := range v {
+		if pred(a, amount) {
+			filtered[a] = amount
+		}
+	}
+	return filtered
+}
+
+// Contains reports whether v holds at least the quantity of every asset,
+// including Lovelace, listed in required.
+//
+// Example:
+//
+//	price := cardanoasset.Value{cardanoasset.Lovelace: 5000000, ticket: 1}
+//	ok := wallet.Contains(price)
+func (v Value) Contains(required Value) bool {
+	for a, amount := range required {
+		if v[a] < amount {
+			return false
+		}
+	}
+	return true
+}
+
+// AssetDelta returns the signed change in the quantity of asset a from
+// before to after, treating an absent asset as zero. The result is a
+// *big.Int because the difference of two uint64 amounts may not fit in int64.
+//
+// Example:
+//
+//	delta := cardanoasset.AssetDelta(previous, current, hosky) // e.g. -250
+func AssetDelta(before, after Value, a Asset) *big.Int {
+	delta := new(big.Int).SetUint64(after[a])
+	return delta.Sub(delta, new(big.Int).SetUint64(before[a]))
+}
+
+// ValueDelta is a signed change between two Values, mapping each asset whose
+// quantity changed to the non-zero difference. Amounts are *big.Int because
+// the difference of two uint64 quantities may not fit in int64.
+type ValueDelta map[Asset]*big.Int
+
+// Assets returns the assets in d in canonical ledger order.
+func (d ValueDelta) Assets() []Asset {
+	assets := make([]Asset, 0, len(d))
+	for a := range d {
+		assets = append(assets, a)
+	}
+	SortAssets(assets)
+	return assets
+}
+
+// SnapshotChangelog returns the step-by-step changes across successive
+// snapshots: element i is snaps[i+1] - snaps[i], containing only the assets
+// whose quantity changed. Iterate each delta with ValueDelta.Assets for a
+// deterministic order. Fewer than two snapshots yield an empty changelog.
+//
+// Example:
+//
+//	for i, delta := range cardanoasset.SnapshotChangelog(snapshots) {
+//	    for _, a := range delta.Assets() {
+//	        fmt.Println(i, a.AssetID(), delta[a])
+//	    }
+//	}
+func SnapshotChangelog(snaps []Value) []ValueDelta {
+	if len(snaps) < 2 {
+		return nil
+	}
+	changelog := make([]ValueDelta, 0, len(snaps)-1)
+	for i := 1; i < len(snaps); i++ {
+		before, after := snaps[i-1], snaps[i]
+		delta := make(ValueDelta)
+		for _, v := range []Value{before, after} {
+			for a := range v {
+				if _, done := delta[a]; done {
+					continue
+				}
+				if d := AssetDelta(before, after, a); d.Sign() != 0 {
+					delta[a] = d
+				}
+			}
+		}
+		changelog = append(changelog, delta)
+	}
+	return changelog
+}
+
+// Assets returns the assets held in v in canonical ledger order: Lovelace
+// first (if present), then by policy ID bytes, then by asset name length and
+// bytes, matching canonical CBOR map key ordering.
+//
+// Example:
+//
+//	for _, a := range v.Assets() {
+//	    fmt.Println(a.AssetID(), v[a])
+//	}
+func (v Value) Assets() []Asset {
+	assets := make([]Asset, 0, len(v))
+	for a := range v {
+		assets = append(assets, a)
+	}
+	SortAssets(assets)
+	return assets
+}
+
+// Policies returns the distinct policy IDs of the native tokens held in v,
+// sorted ascending. Lovelace and zero-amount entries are excluded.
+//
+// Example:
+//
+//	for _, policyID := range v.Policies() {
+//	    fmt.Println(cardanoasset.LookupPolicyName(policyID))
+//	}
+func (v Value) Policies() []string {
+	var policies []string
+	for _, a := range v.Assets() {
+		if a == Lovelace || v[a] == 0 {
+			continue
+		}
+		if n := len(policies); n == 0 || policies[n-1] != a.PolicyID {
+			policies = append(policies, a.PolicyID)
+		}
+	}
+	return policies
+}
+
+// CLIString renders v in the format accepted by cardano-cli --tx-out:
+// "<lovelace> lovelace" followed by "<amount> <policyId>.<nameHex>" terms in
+// canonical ledger order, joined by " + ". Zero-amount entries are omitted;
+// a value with no non-zero entries renders as "0 lovelace". The output parses
+// back to an equal Value with ParseCLIValue.
+//
+// Example:
+//
+//	s := v.CLIString() // "1500000 lovelace + 1 d5e6bf05...d4cc.537061636542756430"
+func (v Value) CLIString() string {
+	terms := make([]string, 0, len(v))
+	for _, a := range v.Assets() {
+		amount := v[a]
+		if amount == 0 {
+			continue
+		}
+		unit := cliLovelaceUnit
+		if a != Lovelace {
+			unit = a.AssetID()
+		}
+		terms = append(terms, strconv.FormatUint(amount, 10)+" "+unit)
+	}
+	if len(terms) == 0 {
+		return "0 " + cliLovelaceUnit
+	}
+	return strings.Join(terms, " + ")
+}
+
+// ParseCLIValue parses a value string in the format printed by cardano-cli,
+// e.g. "1500000 lovelace + 3 <policyId><nameHex> + 1 <policyId>.<nameHex>".
+// Terms are separated by "+" and each is "<amount> <unit>", where the unit is
+// "lovelace", a dotted asset ID, or a concatenated unit. Whitespace around
+// terms is ignored, as are the "TxOutDatum..." annotations cardano-cli appends
+// to UTxO listings. Repeated units are summed.
+// Returns an error wrapping ErrInvalidCLIValue that identifies the offending
+// term, and also the ParseAmount error for a bad amount.
+//
+// Example:
+//
+//	v, err := cardanoasset.ParseCLIValue(
+//	    "1500000 lovelace + 1 d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc.537061636542756430",
+//	)
+func ParseCLIValue(s string) (Value, error) {
+	v := Value{}
+	for i, term := range strings.Split(s, "+") {
+		term = strings.TrimSpace(term)
+		if strings.HasPrefix(term, "TxOutDatum") {
+			continue
+		}
+		fields := strings.Fields(term)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%w: term %d %q: expected \"<amount> <unit>\"", ErrInvalidCLIValue, i+1, term)
+		}
+		amount, err := ParseAmount(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: term %d %q: %w", ErrInvalidCLIValue, i+1, term, err)
+		}
+		a, err := parseCLIUnit(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: term %d %q: %w", ErrInvalidCLIValue, i+1, term, err)
+		}
+		if err := v.Add(a, amount); err != nil {
+			return nil, fmt.Errorf("%w: term %d %q: %w", ErrInvalidCLIValue, i+1, term, err)
+		}
+	}
+	return v, nil
+}
+
+// parseCLIUnit resolves a cardano-cli unit token to an Asset.
+func parseCLIUnit(unit string) (Asset, error) {
+	switch {
+	case unit == cliLovelaceUnit:
+		return Lovelace, nil
+	case strings.Contains(unit, "."):
+		return ParseAssetID(unit)
+	default:
+		return ParseUnit(unit)
+	}
+}
+
+// compareCanonical orders assets as canonical CBOR orders the ledger's
+// multi-asset map keys: by policy ID (all policies have equal length, so
+// lexicographic hex order equals byte order), then by name length, then by
+// name bytes. Lovelace, with its empty policy ID, sorts first.
+func compareCanonical(a, b Asset) int {
+	if a.PolicyID != b.PolicyID {
+		return strings.Compare(a.PolicyID, b.PolicyID)
+	}
+	if len(a.AssetName) != len(b.AssetName) {
+		if len(a.AssetName) < len(b.AssetName) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a.AssetName, b.AssetName)
+}
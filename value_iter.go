@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package cardanoasset
+
+import "iter"
+
+// All returns an iterator over the assets in v and their amounts, in the
+// canonical ledger order used by Assets, so iteration is deterministic.
+// The order is fixed when iteration starts; changes to v during iteration are
+// not reflected in the asset sequence.
+//
+// Example:
+//
+//	for a, amount := range v.All() {
+//	    fmt.Println(a.AssetID(), amount)
+//	}
+func (v Value) All() iter.Seq2[Asset, uint64] {
+	return func(yield func(Asset, uint64) bool) {
+		for _, a := range v.Assets() {
+			if !yield(a, v[a]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+//go:build go1.23
+
+package cardanoasset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValueAll(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	other := Asset{PolicyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"}
+	tests := []struct {
+		name string
+		v    Value
+	}{
+		{name: "empty", v: Value{}},
+		{name: "lovelace only", v: Value{Lovelace: 2000000}},
+		{name: "mixed", v: Value{bud1: 1, Lovelace: 2000000, other: 5, bud0: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var assets []Asset
+			for a, amount := range tt.v.All() {
+				if amount != tt.v[a] {
+					t.Errorf("All() yielded %d for %v, want %d", amount, a, tt.v[a])
+				}
+				assets = append(assets, a)
+			}
+			want := tt.v.Assets()
+			if len(want) == 0 {
+				want = nil
+			}
+			if !reflect.DeepEqual(assets, want) {
+				t.Errorf("All() order = %v, want %v", assets, want)
+			}
+		})
+	}
+}
+
+func TestValueAllStopsEarly(t *testing.T) {
+	v := Value{
+		Lovelace: 1,
+		{PolicyID: testPolicy, AssetName: "SpaceBud0"}: 1,
+		{PolicyID: testPolicy, AssetName: "SpaceBud1"}: 1,
+	}
+	n := 0
+	for range v.All() {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("iterated %d times, want 2", n)
+	}
+}
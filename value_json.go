@@ -0,0 +1,92 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// MarshalJSON implements json.Marshaler using the cardano-cli value layout
+// {"lovelace": n, "<policyId>": {"<assetNameHex>": n}}. Keys are written in
+// canonical ledger order (see Assets) rather than Go's random map order, so
+// equal values always produce byte-identical JSON. Zero-amount entries are
+// kept. Returns the asset's validation error if a key is malformed.
+//
+// Example:
+//
+//	body, err := json.Marshal(cardanoasset.Value{cardanoasset.Lovelace: 2000000, a: 1})
+//	// {"lovelace":2000000,"d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc":{"537061636542756430":1}}
+func (v Value) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	policy := ""
+	for i, a := range v.Assets() {
+		if a == Lovelace {
+			buf.WriteString(`"` + cliLovelaceUnit + `":`)
+			buf.WriteString(strconv.FormatUint(v[a], 10))
+			continue
+		}
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		if a.PolicyID != policy {
+			if policy != "" {
+				buf.WriteByte('}')
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			policy = a.PolicyID
+			buf.WriteString(`"` + policy + `":{`)
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`"` + a.AssetNameHex() + `":`)
+		buf.WriteString(strconv.FormatUint(v[a], 10))
+	}
+	if policy != "" {
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the layout written by
+// MarshalJSON. Returns the NewAssetFromHex error for an invalid policy ID or
+// asset name hex.
+//
+// Example:
+//
+//	var v cardanoasset.Value
+//	err := json.Unmarshal(body, &v)
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	out := make(Value, len(raw))
+	for key, msg := range raw {
+		if key == cliLovelaceUnit {
+			var n uint64
+			if err := json.Unmarshal(msg, &n); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			out[Lovelace] = n
+			continue
+		}
+		var names map[string]uint64
+		if err := json.Unmarshal(msg, &names); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		for nameHex, n := range names {
+			a, err := NewAssetFromHex(key, nameHex)
+			if err != nil {
+				return err
+			}
+			out[a] = n
+		}
+	}
+	*v = out
+	return nil
+}
@@ -0,0 +1,78 @@
+package cardanoasset
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValueMarshalJSON(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	tests := []struct {
+		name    string
+		v       Value
+		want    string
+		wantErr error
+	}{
+		{
+			name: "canonical order",
+			v: Value{
+				{PolicyID: testPolicy, AssetName: "SpaceBud10"}: 1,
+				{PolicyID: testPolicy, AssetName: "SpaceBud9"}:  1,
+				{PolicyID: otherPolicy, AssetName: "PATATE"}:    5,
+				Lovelace:                2000000,
+				{PolicyID: otherPolicy}: 0,
+			},
+			want: `{"lovelace":2000000,` +
+				`"` + otherPolicy + `":{"":0,"504154415445":5},` +
+				`"` + testPolicy + `":{"537061636542756439":1,"53706163654275643130":1}}`,
+		},
+		{name: "no lovelace", v: Value{{PolicyID: testPolicy, AssetName: "SpaceBud0"}: 1}, want: `{"` + testPolicy + `":{"537061636542756430":1}}`},
+		{name: "lovelace only", v: Value{Lovelace: 1}, want: `{"lovelace":1}`},
+		{name: "empty", v: Value{}, want: `{}`},
+		{name: "invalid policy", v: Value{{PolicyID: "abcd", AssetName: "x"}: 1}, wantErr: ErrInvalidPolicyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.v)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("json.Marshal() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() =\n%s\nwant\n%s", got, tt.want)
+			}
+			var back Value
+			if err := json.Unmarshal(got, &back); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(back, tt.v) {
+				t.Errorf("json.Unmarshal(json.Marshal(v)) = %v, want %v", back, tt.v)
+			}
+		})
+	}
+}
+
+func TestValueMarshalJSONDeterministic(t *testing.T) {
+	v := Value{Lovelace: 1}
+	for i := 0; i < 50; i++ {
+		v[Asset{PolicyID: testPolicy, AssetName: string(rune('A' + i))}] = uint64(i)
+	}
+	first, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		got, err := json.Marshal(copyValue(v))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, first) {
+			t.Fatalf("json.Marshal() run %d =\n%s\nwant\n%s", i, got, first)
+		}
+	}
+}
@@ -0,0 +1,485 @@
+package cardanoasset
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseCLIValue(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	tests := []struct {
+		name    string
+		in      string
+		want    Value
+		wantErr error
+	}{
+		{
+			name: "lovelace only",
+			in:   "1500000 lovelace",
+			want: Value{Lovelace: 1500000},
+		},
+		{
+			name: "mixed dotted and concatenated units",
+			in:   "1500000 lovelace + 3 " + testPolicy + "537061636542756430 + 1 " + testPolicy + ".537061636542756431",
+			want: Value{Lovelace: 1500000, bud0: 3, bud1: 1},
+		},
+		{
+			name: "tolerant whitespace",
+			in:   "  1500000   lovelace+3 " + testPolicy + ".537061636542756430 ",
+			want: Value{Lovelace: 1500000, bud0: 3},
+		},
+		{
+			name: "datum annotation ignored",
+			in:   "1500000 lovelace + TxOutDatumNone",
+			want: Value{Lovelace: 1500000},
+		},
+		{
+			name: "repeated units summed",
+			in:   "1 lovelace + 2 lovelace",
+			want: Value{Lovelace: 3},
+		},
+		{name: "empty", in: "", wantErr: ErrInvalidCLIValue},
+		{name: "missing unit", in: "1500000", wantErr: ErrInvalidCLIValue},
+		{name: "bad amount", in: "-1 lovelace", wantErr: ErrAmountNegative},
+		{name: "bad unit", in: "1 ada", wantErr: ErrInvalidCLIValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCLIValue(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseCLIValue(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCLIValue(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// nonZero drops zero-amount entries, which CLIString omits.
+func nonZero(v Value) Value {
+	out := Value{}
+	for a, amount := range v {
+		if amount != 0 {
+			out[a] = amount
+		}
+	}
+	return out
+}
+
+func TestCLIStringRoundTrip(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	other := Asset{PolicyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", AssetName: ""}
+	tests := []struct {
+		name string
+		v    Value
+		want string
+	}{
+		{name: "empty", v: Value{}, want: "0 lovelace"},
+		{name: "zero entries only", v: Value{Lovelace: 0, bud0: 0}, want: "0 lovelace"},
+		{name: "lovelace only", v: Value{Lovelace: 1500000}, want: "1500000 lovelace"},
+		{
+			name: "canonical order",
+			v:    Value{bud1: 1, bud0: 3, Lovelace: 2000000, other: 5},
+			want: "2000000 lovelace + 5 " + other.PolicyID + " + 3 " + testPolicy + ".537061636542756430 + 1 " + testPolicy + ".537061636542756431",
+		},
+		{
+			name: "tokens without lovelace",
+			v:    Value{bud0: 1, bud1: 0},
+			want: "1 " + testPolicy + ".537061636542756430",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v.CLIString()
+			if got != tt.want {
+				t.Fatalf("CLIString() = %q, want %q", got, tt.want)
+			}
+			for i := 0; i < 20; i++ {
+				if again := tt.v.CLIString(); again != got {
+					t.Fatalf("CLIString() not stable: %q then %q", got, again)
+				}
+			}
+			parsed, err := ParseCLIValue(got)
+			if err != nil {
+				t.Fatalf("ParseCLIValue(%q) error = %v", got, err)
+			}
+			if !reflect.DeepEqual(nonZero(parsed), nonZero(tt.v)) {
+				t.Errorf("ParseCLIValue(CLIString()) = %v, want %v", parsed, tt.v)
+			}
+		})
+	}
+}
+
+func TestValueMerge(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	other := Asset{PolicyID: "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"}
+	tests := []struct {
+		name    string
+		a, b    Value
+		want    Value
+		wantErr error
+	}{
+		{
+			name: "overlapping policies",
+			a:    Value{Lovelace: 1000000, bud0: 1},
+			b:    Value{Lovelace: 500000, bud0: 2, bud1: 1, other: 7},
+			want: Value{Lovelace: 1500000, bud0: 3, bud1: 1, other: 7},
+		},
+		{name: "empty receiver", a: Value{}, b: Value{bud0: 1}, want: Value{bud0: 1}},
+		{name: "nil other", a: Value{bud0: 1}, b: nil, want: Value{bud0: 1}},
+		{name: "overflow", a: Value{bud0: math.MaxUint64}, b: Value{bud0: 1}, wantErr: ErrAmountOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := copyValue(tt.a), copyValue(tt.b)
+			got, err := tt.a.Merge(tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Merge() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.a, a) || !reflect.DeepEqual(tt.b, b) {
+				t.Errorf("Merge() modified its inputs: %v, %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestValueFilter(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	v := Value{Lovelace: 1, bud0: 1, bud1: 1, token: 1000}
+	tests := []struct {
+		name string
+		pred func(Asset, uint64) bool
+		want Value
+	}{
+		{
+			name: "nfts only",
+			pred: func(a Asset, amount uint64) bool { return a != Lovelace && amount == 1 },
+			want: Value{bud0: 1, bud1: 1},
+		},
+		{name: "none", pred: func(Asset, uint64) bool { return false }, want: Value{}},
+		{name: "all", pred: func(Asset, uint64) bool { return true }, want: v},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copyValue(v)
+			got := v.Filter(tt.pred)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(v, before) {
+				t.Errorf("Filter() modified the receiver: %v", v)
+			}
+		})
+	}
+}
+
+// copyValue returns a shallow copy of v, or nil for nil.
+func copyValue(v Value) Value {
+	if v == nil {
+		return nil
+	}
+	c := make(Value, len(v))
+	for a, amount := range v {
+		c[a] = amount
+	}
+	return c
+}
+
+func TestValueContains(t *testing.T) {
+	ticket := Asset{PolicyID: testPolicy, AssetName: "Ticket"}
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	wallet := Value{Lovelace: 10000000, ticket: 1, token: 500}
+	tests := []struct {
+		name     string
+		required Value
+		want     bool
+	}{
+		{name: "satisfied", required: Value{Lovelace: 5000000, ticket: 1, token: 500}, want: true},
+		{name: "short on one token", required: Value{Lovelace: 5000000, token: 501}, want: false},
+		{name: "short on lovelace", required: Value{Lovelace: 10000001, ticket: 1}, want: false},
+		{name: "missing token", required: Value{{PolicyID: testPolicy, AssetName: "Other"}: 1}, want: false},
+		{name: "zero requirement on absent asset", required: Value{{PolicyID: testPolicy, AssetName: "Other"}: 0}, want: true},
+		{name: "empty requirement", required: Value{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wallet.Contains(tt.required); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetDelta(t *testing.T) {
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	tests := []struct {
+		name          string
+		before, after Value
+		want          string
+	}{
+		{name: "increase", before: Value{token: 10}, after: Value{token: 25}, want: "15"},
+		{name: "decrease", before: Value{token: 25}, after: Value{token: 10}, want: "-15"},
+		{name: "unchanged", before: Value{token: 10}, after: Value{token: 10}, want: "0"},
+		{name: "appears", before: Value{}, after: Value{token: 3}, want: "3"},
+		{name: "disappears", before: Value{token: 3}, after: nil, want: "-3"},
+		{name: "beyond int64", before: Value{}, after: Value{token: math.MaxUint64}, want: "18446744073709551615"},
+		{name: "below int64", before: Value{token: math.MaxUint64}, after: Value{}, want: "-18446744073709551615"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AssetDelta(tt.before, tt.after, token); got.String() != tt.want {
+				t.Errorf("AssetDelta() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnapshotChangelog(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	token := Asset{PolicyID: testPolicy, AssetName: "TOKEN"}
+	snaps := []Value{
+		{Lovelace: 5000000, token: 100},
+		{Lovelace: 4000000, token: 100, bud0: 1},
+		{Lovelace: 4000000, token: 40},
+	}
+	want := []map[Asset]string{
+		{Lovelace: "-1000000", bud0: "1"},
+		{token: "-60", bud0: "-1"},
+	}
+	got := SnapshotChangelog(snaps)
+	if len(got) != len(want) {
+		t.Fatalf("len(SnapshotChangelog) = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if len(got[i]) != len(want[i]) {
+				t.Errorf("delta %d has %d entries, want %d", i, len(got[i]), len(want[i]))
+			}
+			for a, amount := range want[i] {
+				if d, ok := got[i][a]; !ok || d.String() != amount {
+					t.Errorf("delta %d [%v] = %v, want %s", i, a, d, amount)
+				}
+			}
+			assets := got[i].Assets()
+			for j := 1; j < len(assets); j++ {
+				if compareCanonical(assets[j-1], assets[j]) >= 0 {
+					t.Errorf("ValueDelta.Assets() not in canonical order: %v", assets)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshotChangelogShort(t *testing.T) {
+	tests := []struct {
+		name  string
+		snaps []Value
+	}{
+		{name: "nil", snaps: nil},
+		{name: "single", snaps: []Value{{Lovelace: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SnapshotChangelog(tt.snaps); len(got) != 0 {
+				t.Errorf("SnapshotChangelog() = %v, want empty", got)
+			}
+		})
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	tests := []struct {
+		name string
+		v    Value
+		want []string
+	}{
+		{
+			name: "two policies plus lovelace",
+			v: Value{
+				Lovelace: 2000000,
+				{PolicyID: testPolicy, AssetName: "SpaceBud0"}: 1,
+				{PolicyID: testPolicy, AssetName: "SpaceBud1"}: 1,
+				{PolicyID: otherPolicy, AssetName: "PATATE"}:   5,
+				{PolicyID: otherPolicy}:                        7,
+			},
+			want: []string{otherPolicy, testPolicy},
+		},
+		{
+			name: "zero amounts ignored",
+			v: Value{
+				{PolicyID: testPolicy, AssetName: "SpaceBud0"}: 0,
+				{PolicyID: otherPolicy, AssetName: "PATATE"}:   1,
+			},
+			want: []string{otherPolicy},
+		},
+		{name: "lovelace only", v: Value{Lovelace: 1}, want: nil},
+		{name: "empty", v: Value{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Policies(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Policies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	tests := []struct {
+		name        string
+		v, other    Value
+		want        Value
+		wantErr     error
+		wantErrText string
+	}{
+		{
+			name:  "exact cancellation",
+			v:     Value{Lovelace: 5000000, bud0: 1},
+			other: Value{Lovelace: 5000000, bud0: 1},
+			want:  Value{},
+		},
+		{
+			name:  "partial",
+			v:     Value{Lovelace: 5000000, bud0: 1, bud1: 3},
+			other: Value{Lovelace: 2000000, bud1: 1},
+			want:  Value{Lovelace: 3000000, bud0: 1, bud1: 2},
+		},
+		{
+			name:  "zero entries tolerated",
+			v:     Value{Lovelace: 1, bud0: 0},
+			other: Value{bud0: 0, bud1: 0},
+			want:  Value{Lovelace: 1},
+		},
+		{name: "both empty", v: Value{}, other: nil, want: Value{}},
+		{
+			name:        "over-subtraction of token",
+			v:           Value{Lovelace: 5000000, bud0: 1},
+			other:       Value{bud0: 2},
+			wantErr:     ErrAmountUnderflow,
+			wantErrText: bud0.AssetID(),
+		},
+		{
+			name:        "missing token",
+			v:           Value{Lovelace: 5000000},
+			other:       Value{bud1: 1},
+			wantErr:     ErrAmountUnderflow,
+			wantErrText: bud1.AssetID(),
+		},
+		{
+			name:        "over-subtraction of lovelace",
+			v:           Value{Lovelace: 1},
+			other:       Value{Lovelace: 2},
+			wantErr:     ErrAmountUnderflow,
+			wantErrText: "lovelace",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copyValue(tt.v)
+			got, err := tt.v.Diff(tt.other)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Diff() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.v, before) {
+				t.Errorf("Diff() modified receiver: %v, want %v", tt.v, before)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), tt.wantErrText) {
+					t.Errorf("Diff() error = %q, want it to name %q", err, tt.wantErrText)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsADAOnly(t *testing.T) {
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	tests := []struct {
+		name string
+		v    Value
+		want bool
+	}{
+		{name: "ada only", v: Value{Lovelace: 5000000}, want: true},
+		{name: "empty", v: Value{}, want: true},
+		{name: "nil", v: nil, want: true},
+		{name: "zero-amount token ignored", v: Value{Lovelace: 1, bud0: 0}, want: true},
+		{name: "multi-asset", v: Value{Lovelace: 5000000, bud0: 1}, want: false},
+		{name: "token without lovelace", v: Value{bud0: 1}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.IsADAOnly(); got != tt.want {
+				t.Errorf("IsADAOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetCountAndTotalQuantity(t *testing.T) {
+	const otherPolicy = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+	bud0 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud0"}
+	bud1 := Asset{PolicyID: testPolicy, AssetName: "SpaceBud1"}
+	patate := Asset{PolicyID: otherPolicy, AssetName: "PATATE"}
+	tests := []struct {
+		name      string
+		v         Value
+		wantCount int
+		wantTotal uint64
+		wantErr   error
+	}{
+		{name: "empty", v: Value{}},
+		{name: "lovelace only", v: Value{Lovelace: 5000000}},
+		{
+			name:      "multi-asset",
+			v:         Value{Lovelace: 5000000, bud0: 1, bud1: 1, patate: 40, {PolicyID: otherPolicy}: 0},
+			wantCount: 3,
+			wantTotal: 42,
+		},
+		{
+			name:      "overflow",
+			v:         Value{bud0: math.MaxUint64, bud1: 1},
+			wantCount: 2,
+			wantErr:   ErrAmountOverflow,
+		},
+		{
+			name:      "max without overflow",
+			v:         Value{Lovelace: math.MaxUint64, bud0: math.MaxUint64 - 1, bud1: 1},
+			wantCount: 2,
+			wantTotal: math.MaxUint64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.AssetCount(); got != tt.wantCount {
+				t.Errorf("AssetCount() = %d, want %d", got, tt.wantCount)
+			}
+			got, err := tt.v.TotalQuantity()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TotalQuantity() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.wantTotal {
+				t.Errorf("TotalQuantity() = %d, want %d", got, tt.wantTotal)
+			}
+		})
+	}
+}